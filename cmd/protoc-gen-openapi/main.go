@@ -0,0 +1,33 @@
+// protoc-gen-openapi generates the OpenAPI v3 document of the google.api.http
+// annotated methods of each proto file, as <file>.openapi.json.
+package main
+
+import (
+	"github.com/susana-garcia/go-crud/internal/openapi"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func main() {
+	protogen.Options{}.Run(func(plugin *protogen.Plugin) error {
+		for _, file := range plugin.Files {
+			if !file.Generate {
+				continue
+			}
+			doc, err := openapi.Generate(file.Desc)
+			if err != nil {
+				return err
+			}
+			if len(doc.Paths) == 0 {
+				continue
+			}
+			data, err := doc.JSON()
+			if err != nil {
+				return err
+			}
+			if _, err := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+".openapi.json", "").Write(data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -12,17 +12,38 @@ import (
 	"gorm.io/gorm"
 )
 
+// pageTokenSecretPlaceholder is the example value of PAGE_TOKEN_SECRET, refused at startup
+const pageTokenSecretPlaceholder = "change-me"
+
 // Config holds all configuration for our application
 type Config struct {
 	Server
 	Database
-	LogLevel string
-	Debug    bool
+	LogLevel          string
+	Debug             bool
+	IdempotencyKeyTTL time.Duration
+	PageTokenSecret   string
+	SearchLanguage    string
+	WatchBufferSize   int
+	ChangeRetention   time.Duration
+	DeletedRetention  time.Duration
+	PublishInterval   time.Duration
+	ViewFlushInterval time.Duration
+	// PingInterval is how often the database is pinged to report the health status
+	PingInterval time.Duration
+	// ShutdownDelay is how long the server reports NOT_SERVING before draining connections on shutdown
+	ShutdownDelay time.Duration
+	// ShutdownTimeout is how long connections are drained before being closed on shutdown
+	ShutdownTimeout time.Duration
+	// OpenAPIViewer serves a page rendering the OpenAPI document at /docs of the gateway
+	OpenAPIViewer bool
 }
 
 type Server struct {
 	Port string
 	Host string
+	// GatewayPort is the port of the REST/JSON gateway
+	GatewayPort string
 }
 
 type Database struct {
@@ -47,8 +68,9 @@ func Load() *Config {
 
 	config := &Config{
 		Server: Server{
-			Port: GetEnv("PORT", "8080"),
-			Host: GetEnv("HOST", "localhost"),
+			Port:        GetEnv("PORT", "8080"),
+			Host:        GetEnv("HOST", "localhost"),
+			GatewayPort: GetEnv("GATEWAY_PORT", "8081"),
 		},
 		Database: Database{
 			Port:     GetEnv("DB_PORT", "5432"),
@@ -57,8 +79,25 @@ func Load() *Config {
 			User:     GetEnv("DB_USER", "gocrud"),
 			Password: GetEnv("DB_PASSWORD", "gocrud"),
 		},
-		LogLevel: GetEnv("LOG_LEVEL", "info"),
-		Debug:    GetEnvBool("DEBUG", false),
+		LogLevel:          GetEnv("LOG_LEVEL", "info"),
+		Debug:             GetEnvBool("DEBUG", false),
+		IdempotencyKeyTTL: GetEnvDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour),
+		PageTokenSecret:   GetEnv("PAGE_TOKEN_SECRET", ""),
+		SearchLanguage:    GetEnv("SEARCH_LANGUAGE", "english"),
+		WatchBufferSize:   GetEnvInt("WATCH_BUFFER_SIZE", 100),
+		ChangeRetention:   GetEnvDuration("CHANGE_RETENTION", 24*time.Hour),
+		DeletedRetention:  GetEnvDuration("DELETED_RETENTION", 30*24*time.Hour),
+		PublishInterval:   GetEnvDuration("PUBLISH_INTERVAL", 10*time.Second),
+		ViewFlushInterval: GetEnvDuration("VIEW_FLUSH_INTERVAL", 10*time.Second),
+		OpenAPIViewer:     GetEnvBool("OPENAPI_VIEWER", false),
+		PingInterval:      GetEnvDuration("HEALTH_PING_INTERVAL", 5*time.Second),
+		ShutdownDelay:     GetEnvDuration("SHUTDOWN_DELAY", 5*time.Second),
+		ShutdownTimeout:   GetEnvDuration("SHUTDOWN_TIMEOUT", 30*time.Second),
+	}
+
+	// page tokens must be valid on every instance and across restarts, so the secret is shared and required
+	if config.PageTokenSecret == "" || config.PageTokenSecret == pageTokenSecretPlaceholder {
+		log.Fatal("PAGE_TOKEN_SECRET must be set to a secret shared by all instances")
 	}
 
 	log.Printf("configuration loaded: port=%s, host=%s, log_level=%s, debug=%t",
@@ -73,7 +112,9 @@ func OpenConnection(cfg Database) *gorm.DB {
 	database, err := gorm.Open(postgres.New(postgres.Config{
 		DSN:                  dsn,
 		PreferSimpleProtocol: true, // disables implicit prepared statement usage
-	}), &gorm.Config{})
+	}), &gorm.Config{
+		TranslateError: true, // translates database errors into gorm errors such as gorm.ErrDuplicatedKey
+	})
 	if err != nil {
 		log.Fatal("unable to connect to the database: %w", err)
 	}
@@ -110,3 +151,23 @@ func GetEnvBool(key string, defaultValue bool) bool {
 	}
 	return defaultValue
 }
+
+// GetEnvInt gets an integer environment variable or returns a default value
+func GetEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
+// GetEnvDuration gets a duration environment variable or returns a default value
+func GetEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,55 @@
+package gateway
+
+import (
+	"context"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/susana-garcia/go-crud/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// options are the options of the gateway
+type options struct {
+	headers map[string]bool
+}
+
+// Option configures optional behaviour of the gateway
+type Option func(*options)
+
+// WithForwardedHeaders sets the HTTP headers sent to the gRPC server as metadata
+func WithForwardedHeaders(headers ...string) Option {
+	return func(o *options) {
+		for _, header := range headers {
+			o.headers[textproto.CanonicalMIMEHeaderKey(header)] = true
+		}
+	}
+}
+
+// New returns a handler serving the Blogger RPCs annotated with google.api.http
+// rules as JSON over HTTP, with the handlers generated by
+// protoc-gen-grpc-gateway. Requests are sent to the gRPC server through conn,
+// so they go through the same interceptors as gRPC requests, and gRPC status
+// codes are returned as the matching HTTP statuses.
+func New(ctx context.Context, conn grpc.ClientConnInterface, opts ...Option) (*runtime.ServeMux, error) {
+	o := &options{headers: map[string]bool{}}
+	for _, opt := range opts {
+		opt(o)
+	}
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+		}),
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if o.headers[textproto.CanonicalMIMEHeaderKey(key)] {
+				return key, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+	)
+	if err := pb.RegisterBloggerHandlerClient(ctx, mux, pb.NewBloggerClient(conn)); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
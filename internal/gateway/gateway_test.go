@@ -0,0 +1,181 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeConn records the calls of the gateway and answers them with err or an empty response
+type fakeConn struct {
+	method string
+	req    proto.Message
+	md     metadata.MD
+	err    error
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	c.method = method
+	c.req = args.(proto.Message)
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	if res, ok := reply.(*pb.CreateBlogResponse); ok {
+		res.Id = 7
+	}
+	return c.err
+}
+
+func (c *fakeConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streams are not supported")
+}
+
+func TestGateway(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		header     http.Header
+		err        error
+		wantStatus int
+		wantMethod string
+		wantReq    proto.Message
+		wantMD     metadata.MD
+		wantBody   string
+	}{
+		{
+			name:       "should bind path variables",
+			method:     http.MethodGet,
+			target:     "/v1/blogs/5",
+			wantStatus: http.StatusOK,
+			wantMethod: "/pb.Blogger/GetBlog",
+			wantReq:    &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: 5}},
+		},
+		{
+			name:       "should bind query parameters by proto or json name",
+			method:     http.MethodGet,
+			target:     "/v1/blogs?limit=10&statuses=BLOG_STATUS_DRAFT&statuses=3&authorId=2",
+			wantStatus: http.StatusOK,
+			wantMethod: "/pb.Blogger/GetBlogs",
+			wantReq: &pb.GetBlogsRequest{
+				Limit:    10,
+				Statuses: []pb.BlogStatus{pb.BlogStatus_BLOG_STATUS_DRAFT, pb.BlogStatus_BLOG_STATUS_PUBLISHED},
+				AuthorId: 2,
+			},
+		},
+		{
+			name:       "should bind custom verbs and additional bindings",
+			method:     http.MethodGet,
+			target:     "/v1/blogs:lookup?slug=hello-world&count_view=true",
+			wantStatus: http.StatusOK,
+			wantMethod: "/pb.Blogger/GetBlog",
+			wantReq:    &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Slug{Slug: "hello-world"}, CountView: true},
+		},
+		{
+			name:       "should unescape path variables",
+			method:     http.MethodPost,
+			target:     "/v1/tags/c%23:rename",
+			body:       `{"new_name": "csharp"}`,
+			wantStatus: http.StatusOK,
+			wantMethod: "/pb.Blogger/RenameTag",
+			wantReq:    &pb.RenameTagRequest{Name: "c#", NewName: "csharp"},
+		},
+		{
+			name:       "should decode the body and forward headers",
+			method:     http.MethodPost,
+			target:     "/v1/blogs",
+			body:       `{"title": "hello", "tags": ["go"]}`,
+			header:     http.Header{"Idempotency-Key": {"abc"}, "Authorization": {"secret"}},
+			wantStatus: http.StatusOK,
+			wantMethod: "/pb.Blogger/CreateBlog",
+			wantReq:    &pb.CreateBlogRequest{Title: "hello", Tags: []string{"go"}},
+			wantMD:     metadata.MD{"idempotency-key": {"abc"}, "grpcgateway-authorization": {"secret"}},
+			wantBody:   `{"id":7}`,
+		},
+		{
+			name:       "should let path variables win over the body",
+			method:     http.MethodPatch,
+			target:     "/v1/blogs/3",
+			body:       `{"id": 4, "title": "hello"}`,
+			wantStatus: http.StatusOK,
+			wantMethod: "/pb.Blogger/UpdateBlog",
+			wantReq:    &pb.UpdateBlogRequest{Id: 3, Title: proto.String("hello")},
+		},
+		{
+			name:       "should map status codes",
+			method:     http.MethodDelete,
+			target:     "/v1/blogs/3?etag=abc",
+			err:        status.Error(codes.FailedPrecondition, "etag does not match"),
+			wantStatus: http.StatusBadRequest,
+			wantMethod: "/pb.Blogger/DeleteBlog",
+			wantReq:    &pb.DeleteBlogRequest{Id: 3, Etag: "abc"},
+			wantBody:   `{"code":9,"message":"etag does not match","details":[]}`,
+		},
+		{
+			name:       "should ignore unknown query parameters",
+			method:     http.MethodGet,
+			target:     "/v1/blogs?size=10",
+			wantStatus: http.StatusOK,
+			wantMethod: "/pb.Blogger/GetBlogs",
+			wantReq:    &pb.GetBlogsRequest{},
+		},
+		{
+			name:       "should fail on invalid values",
+			method:     http.MethodGet,
+			target:     "/v1/blogs/abc",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"code":3,"message":"type mismatch, parameter: id, error: strconv.ParseUint: parsing \"abc\": invalid syntax","details":[]}`,
+		},
+		{
+			name:       "should fail on unknown routes",
+			method:     http.MethodGet,
+			target:     "/v1/unknown",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "should fail on methods not bound to the path",
+			method:     http.MethodPut,
+			target:     "/v1/blogs/3",
+			wantStatus: http.StatusNotImplemented,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &fakeConn{err: tt.err}
+			gw, err := New(context.Background(), conn, WithForwardedHeaders("Idempotency-Key"))
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			for name, values := range tt.header {
+				req.Header[name] = values
+			}
+			rec := httptest.NewRecorder()
+			gw.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.True(t, json.Valid(rec.Body.Bytes()))
+			assert.Equal(t, tt.wantMethod, conn.method)
+			if tt.wantReq != nil {
+				assert.True(t, proto.Equal(tt.wantReq, conn.req), "got request %v", conn.req)
+			}
+			for key, values := range tt.wantMD {
+				assert.Equal(t, values, conn.md.Get(key))
+			}
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
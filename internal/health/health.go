@@ -0,0 +1,178 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// DefaultPingInterval is how often the database is pinged
+	DefaultPingInterval = 5 * time.Second
+	// DefaultPingTimeout is how long a ping can take before the database is considered down
+	DefaultPingTimeout = 2 * time.Second
+)
+
+// errNotPinged is the ping error until the database is pinged for the first time
+var errNotPinged = errors.New("database not pinged yet")
+
+// Pinger checks the connection to the database, as *sql.DB does
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// Checker serves the grpc.health.v1.Health service. The server and its
+// services are serving once the database migration is done, and as long as
+// the database answers pings.
+type Checker struct {
+	server       *health.Server
+	pinger       Pinger
+	logger       *slog.Logger
+	services     []string
+	pingInterval time.Duration
+	pingTimeout  time.Duration
+
+	mu       sync.Mutex
+	migrated bool
+	pingErr  error
+	status   healthpb.HealthCheckResponse_ServingStatus
+}
+
+// Option configures optional behaviour of the Checker
+type Option func(*Checker)
+
+// WithPingInterval sets how often the database is pinged
+func WithPingInterval(interval time.Duration) Option {
+	return func(c *Checker) {
+		c.pingInterval = interval
+	}
+}
+
+// WithPingTimeout sets how long a ping can take before the database is considered down
+func WithPingTimeout(timeout time.Duration) Option {
+	return func(c *Checker) {
+		c.pingTimeout = timeout
+	}
+}
+
+// New returns a checker of the server and of the named services, not serving
+// until the migration is done and the database is pinged
+func New(pinger Pinger, logger *slog.Logger, services []string, opts ...Option) *Checker {
+	c := &Checker{
+		server:       health.NewServer(),
+		pinger:       pinger,
+		logger:       logger,
+		services:     append([]string{""}, services...),
+		pingInterval: DefaultPingInterval,
+		pingTimeout:  DefaultPingTimeout,
+		pingErr:      errNotPinged,
+		status:       healthpb.HealthCheckResponse_NOT_SERVING,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, c.status)
+	}
+	return c
+}
+
+// Register registers the health service on the gRPC server
+func (c *Checker) Register(reg grpc.ServiceRegistrar) {
+	healthpb.RegisterHealthServer(reg, c.server)
+}
+
+// SetMigrated marks the database migration as done
+func (c *Checker) SetMigrated() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.migrated = true
+	c.update()
+}
+
+// Run pings the database at every interval until the context is done
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.pingInterval)
+	defer ticker.Stop()
+	for {
+		c.ping(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ping pings the database and updates the status with the result
+func (c *Checker) ping(ctx context.Context) {
+	pingCtx, cancel := context.WithTimeout(ctx, c.pingTimeout)
+	defer cancel()
+	err := c.pinger.PingContext(pingCtx)
+	if ctx.Err() != nil {
+		// stopping, the database is not the cause
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pingErr = err
+	c.update()
+}
+
+// update sets the status of the services when it changed, c.mu must be held
+func (c *Checker) update() {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if c.migrated && c.pingErr == nil {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	if status == c.status {
+		return
+	}
+	c.status = status
+	if status == healthpb.HealthCheckResponse_SERVING {
+		c.logger.Info("health status changed", "status", status)
+	} else {
+		c.logger.Warn("health status changed", "status", status, "migrated", c.migrated, "error", c.pingErr)
+	}
+	// watchers of the services are sent the new status
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
+
+// Shutdown sets the services as not serving and ignores later changes, so
+// that clients stop sending requests to a server shutting down
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+// LivezHandler answers OK as long as the process serves HTTP requests
+func LivezHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte("ok\n"))
+	})
+}
+
+// ReadyzHandler answers OK when the server is serving, and 503 otherwise, with
+// the serving status as body
+func (c *Checker) ReadyzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := healthpb.HealthCheckResponse_NOT_SERVING
+		if res, err := c.server.Check(r.Context(), &healthpb.HealthCheckRequest{}); err == nil {
+			status = res.GetStatus()
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if status != healthpb.HealthCheckResponse_SERVING {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_, _ = w.Write([]byte(status.String() + "\n"))
+	})
+}
@@ -0,0 +1,109 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakePinger answers pings with err
+type fakePinger struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (p *fakePinger) PingContext(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+func (p *fakePinger) setErr(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	pinger := &fakePinger{}
+	checker := New(pinger, logger, []string{"pb.Blogger"})
+
+	s := grpc.NewServer()
+	checker.Register(s)
+	lis := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	defer s.Stop()
+	conn, err := grpc.NewClient("passthrough://bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	client := healthpb.NewHealthClient(conn)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.Watch(watchCtx, &healthpb.HealthCheckRequest{Service: "pb.Blogger"})
+	assert.NoError(t, err)
+	next := func() healthpb.HealthCheckResponse_ServingStatus {
+		res, err := stream.Recv()
+		assert.NoError(t, err)
+		return res.GetStatus()
+	}
+	readyz := func() int {
+		rec := httptest.NewRecorder()
+		checker.ReadyzHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		return rec.Code
+	}
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, next())
+
+	// not serving until the migration is done, even when the database answers
+	checker.ping(ctx)
+	res, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.GetStatus())
+	assert.Equal(t, http.StatusServiceUnavailable, readyz())
+
+	checker.SetMigrated()
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, next())
+	assert.Equal(t, http.StatusOK, readyz())
+
+	// failed pings are streamed to watchers, until the database answers again
+	pinger.setErr(errors.New("connection refused"))
+	checker.ping(ctx)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, next())
+	assert.Equal(t, http.StatusServiceUnavailable, readyz())
+	pinger.setErr(nil)
+	checker.ping(ctx)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, next())
+
+	// shutting down stops serving for good
+	checker.Shutdown()
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, next())
+	checker.ping(ctx)
+	assert.Equal(t, http.StatusServiceUnavailable, readyz())
+
+	rec := httptest.NewRecorder()
+	LivezHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,176 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// apiVersion is the version of the API, as in the /v1 prefix of the paths
+const apiVersion = "v1"
+
+// statusDescriptor is the message of the errors returned by the gateway
+var statusDescriptor = (&spb.Status{}).ProtoReflect().Descriptor()
+
+// generator collects the schemas of the messages and enums used by the operations
+type generator struct {
+	schemas map[string]*Schema
+}
+
+// Generate returns the OpenAPI document of the methods of the file with a
+// google.api.http annotation. The document has no paths when there are none.
+// Bodies and responses are described with the protobuf JSON mapping and the
+// protovalidate rules of the fields.
+func Generate(file protoreflect.FileDescriptor) (*Document, error) {
+	g := &generator{schemas: map[string]*Schema{}}
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    Info{Version: apiVersion},
+		Paths:   map[string]map[string]*Operation{},
+	}
+	var titles []string
+	services := file.Services()
+	for i := range services.Len() {
+		service := services.Get(i)
+		found := false
+		methods := service.Methods()
+		for j := range methods.Len() {
+			method := methods.Get(j)
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				continue
+			}
+			rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+			for k, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+				httpMethod, path, op, err := g.operation(method, binding)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", method.FullName(), err)
+				}
+				op.OperationID = fmt.Sprintf("%s_%s", service.Name(), method.Name())
+				if k > 0 {
+					op.OperationID += fmt.Sprint(k + 1)
+				}
+				if doc.Paths[path] == nil {
+					doc.Paths[path] = map[string]*Operation{}
+				}
+				doc.Paths[path][strings.ToLower(httpMethod)] = op
+			}
+			found = true
+		}
+		if found {
+			titles = append(titles, string(service.Name()))
+			doc.Tags = append(doc.Tags, Tag{Name: string(service.Name()), Description: comments(service)})
+		}
+	}
+	doc.Info.Title = strings.Join(titles, ", ")
+	if len(doc.Paths) > 0 {
+		// errors are returned as JSON google.rpc.Status
+		g.addMessage(statusDescriptor)
+	}
+	doc.Components.Schemas = g.schemas
+	return doc, nil
+}
+
+// operation returns the operation of the HTTP binding of the method
+func (g *generator) operation(method protoreflect.MethodDescriptor, rule *annotations.HttpRule) (string, string, *Operation, error) {
+	var httpMethod, path string
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		httpMethod, path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		httpMethod, path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		httpMethod, path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Patch:
+		httpMethod, path = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Delete:
+		httpMethod, path = http.MethodDelete, pattern.Delete
+	default:
+		return "", "", nil, fmt.Errorf("unsupported pattern %v", pattern)
+	}
+	op := &Operation{
+		Description: comments(method),
+		Tags:        []string{string(method.Parent().Name())},
+		Responses: map[string]*Response{
+			"200": {
+				Description: "A successful response.",
+				Content:     jsonContent(g.messageSchema(method.Output())),
+			},
+			"default": {
+				Description: "An error response.",
+				Content:     jsonContent(&Schema{Ref: ref(statusDescriptor.FullName())}),
+			},
+		},
+	}
+
+	input := method.Input()
+	inPath := map[protoreflect.Name]bool{}
+	for part := range strings.SplitSeq(path, "/") {
+		start, end := strings.Index(part, "{"), strings.Index(part, "}")
+		if start < 0 || end < start {
+			continue
+		}
+		name := protoreflect.Name(part[start+1 : end])
+		field := input.Fields().ByName(name)
+		if field == nil || field.IsList() || field.IsMap() || field.Message() != nil {
+			return "", "", nil, fmt.Errorf("path variable %q must be a scalar field of the request", name)
+		}
+		inPath[name] = true
+		op.Parameters = append(op.Parameters, g.parameter(field, "path"))
+	}
+
+	switch rule.GetBody() {
+	case "*":
+		op.RequestBody = &RequestBody{Required: true, Content: jsonContent(g.messageSchema(input))}
+	case "":
+		fields := input.Fields()
+		for i := range fields.Len() {
+			field := fields.Get(i)
+			if inPath[field.Name()] || field.IsMap() {
+				continue
+			}
+			// messages can only be set as query parameters when their JSON value is a string
+			if p := g.parameter(field, "query"); !g.isObject(p.Schema) {
+				op.Parameters = append(op.Parameters, p)
+			}
+		}
+	default:
+		return "", "", nil, fmt.Errorf("unsupported body %q", rule.GetBody())
+	}
+	return httpMethod, path, op, nil
+}
+
+// parameter returns the parameter of the field, with the description of the field
+func (g *generator) parameter(field protoreflect.FieldDescriptor, in string) *Parameter {
+	schema := g.fieldSchema(field)
+	p := &Parameter{
+		Name:        string(field.Name()),
+		In:          in,
+		Description: schema.Description,
+		Required:    in == "path" || fieldRules(field).GetRequired(),
+		Schema:      schema,
+	}
+	schema.Description = ""
+	return p
+}
+
+// comments returns the leading comments of the descriptor, without comment markers
+func comments(desc protoreflect.Descriptor) string {
+	comments := desc.ParentFile().SourceLocations().ByDescriptor(desc).LeadingComments
+	var lines []string
+	for line := range strings.SplitSeq(strings.TrimSpace(comments), "\n") {
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
@@ -0,0 +1,33 @@
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// document is the OpenAPI document of pb/blog.proto, generated by protoc-gen-openapi
+//
+//go:embed blog.openapi.json
+var document []byte
+
+// viewer is a Swagger UI page loading the document from openapi.json next to it
+//
+//go:embed viewer.html
+var viewer []byte
+
+// Handler serves the OpenAPI document of the Blogger service
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(document)
+	})
+}
+
+// ViewerHandler serves a page rendering the document served at openapi.json,
+// with the Swagger UI assets loaded from a CDN
+func ViewerHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(viewer)
+	})
+}
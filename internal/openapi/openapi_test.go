@@ -0,0 +1,94 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+)
+
+func TestGenerate(t *testing.T) {
+	doc, err := Generate(pb.File_blog_proto)
+	assert.NoError(t, err)
+	assert.Equal(t, "Blogger", doc.Info.Title)
+
+	get := doc.Paths["/v1/blogs/{id}"]["get"]
+	assert.Equal(t, "Blogger_GetBlog", get.OperationID)
+	assert.Equal(t, "Blogger_GetBlog2", doc.Paths["/v1/blogs:lookup"]["get"].OperationID)
+	assert.Equal(t, &Parameter{Name: "id", In: "path", Required: true, Schema: &Schema{Type: "integer", Format: "int64", Minimum: uint32(1)}}, get.Parameters[0])
+	assert.Equal(t, ref("google.rpc.Status"), get.Responses["default"].Content["application/json"].Schema.Ref)
+
+	create := doc.Paths["/v1/blogs"]["post"]
+	assert.Equal(t, ref("pb.CreateBlogRequest"), create.RequestBody.Content["application/json"].Schema.Ref)
+	request := doc.Components.Schemas["pb.CreateBlogRequest"]
+	assert.Equal(t, uint64(3), request.Properties["title"].MinLength)
+	assert.Equal(t, uint64(30), request.Properties["title"].MaxLength)
+	assert.Equal(t, uint64(10), request.Properties["tags"].MaxItems)
+	assert.Equal(t, uint64(50), request.Properties["tags"].Items.MaxLength)
+	assert.Equal(t, []any{"BLOG_STATUS_UNSPECIFIED", "BLOG_STATUS_DRAFT", "BLOG_STATUS_PUBLISHED"}, request.Properties["status"].Enum)
+
+	// query parameters have the rules of their fields
+	var limit *Parameter
+	for _, p := range doc.Paths["/v1/blogs:search"]["get"].Parameters {
+		if p.Name == "limit" {
+			limit = p
+		}
+	}
+	assert.Equal(t, &Schema{Type: "integer", Format: "int32", ExclusiveMaximum: int32(100)}, limit.Schema)
+
+	// required fields and well-known types
+	assert.Equal(t, []string{"item"}, doc.Components.Schemas["pb.GetBlogResponse"].Required)
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, doc.Components.Schemas["pb.Blog"].Properties["created_at"])
+}
+
+// the embedded document is the one generated from pb/blog.proto, comments aside
+func TestDocumentUpToDate(t *testing.T) {
+	doc, err := Generate(pb.File_blog_proto)
+	assert.NoError(t, err)
+	generated, err := doc.JSON()
+	assert.NoError(t, err)
+
+	var want, got any
+	assert.NoError(t, json.Unmarshal(generated, &want))
+	assert.NoError(t, json.Unmarshal(document, &got))
+	assert.Equal(t, withoutDescriptions(want), withoutDescriptions(got), "the OpenAPI document is out of date, run go generate")
+}
+
+// withoutDescriptions removes the descriptions from the decoded JSON value,
+// as generated files have no comments
+func withoutDescriptions(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if key == "description" {
+				if _, ok := child.(string); ok {
+					delete(v, key)
+					continue
+				}
+			}
+			v[key] = withoutDescriptions(child)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = withoutDescriptions(child)
+		}
+	}
+	return value
+}
+
+func TestHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var doc Document
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	assert.Equal(t, Version, doc.OpenAPI)
+	assert.NotEmpty(t, doc.Paths)
+
+	rec = httptest.NewRecorder()
+	ViewerHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	assert.Contains(t, rec.Body.String(), `url: "openapi.json"`)
+}
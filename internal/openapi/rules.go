@@ -0,0 +1,148 @@
+package openapi
+
+import (
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// numberKeywords sets the JSON Schema keywords of the numeric rules
+var numberKeywords = map[protoreflect.Name]func(*Schema, any){
+	"const": func(s *Schema, v any) { s.Const = v },
+	"lt":    func(s *Schema, v any) { s.ExclusiveMaximum = v },
+	"lte":   func(s *Schema, v any) { s.Maximum = v },
+	"gt":    func(s *Schema, v any) { s.ExclusiveMinimum = v },
+	"gte":   func(s *Schema, v any) { s.Minimum = v },
+}
+
+// stringKeywords sets the JSON Schema keywords of the string rules
+var stringKeywords = map[protoreflect.Name]func(*Schema, any){
+	"const":   func(s *Schema, v any) { s.Const = v },
+	"len":     func(s *Schema, v any) { s.MinLength, s.MaxLength = v, v },
+	"min_len": func(s *Schema, v any) { s.MinLength = v },
+	"max_len": func(s *Schema, v any) { s.MaxLength = v },
+	"pattern": func(s *Schema, v any) { s.Pattern = v.(string) },
+}
+
+// stringFormats are the formats of the well-known string rules
+var stringFormats = map[protoreflect.Name]string{
+	"email":    "email",
+	"hostname": "hostname",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"uri":      "uri",
+	"uri_ref":  "uri-reference",
+	"uuid":     "uuid",
+}
+
+func fieldRules(field protoreflect.FieldDescriptor) *validate.FieldRules {
+	rules, _ := proto.GetExtension(field.Options(), validate.E_Field).(*validate.FieldRules)
+	return rules
+}
+
+func messageRules(msg protoreflect.MessageDescriptor) *validate.MessageRules {
+	rules, _ := proto.GetExtension(msg.Options(), validate.E_Message).(*validate.MessageRules)
+	return rules
+}
+
+func oneofRules(oneof protoreflect.OneofDescriptor) *validate.OneofRules {
+	rules, _ := proto.GetExtension(oneof.Options(), validate.E_Oneof).(*validate.OneofRules)
+	return rules
+}
+
+// applyRules sets the JSON Schema keywords of the type rules of the field.
+// Rules without a JSON Schema equivalent, such as CEL expressions, are skipped.
+func applyRules(schema *Schema, field protoreflect.FieldDescriptor, rules *validate.FieldRules) {
+	if rules == nil || rules.GetIgnore() == validate.Ignore_IGNORE_ALWAYS {
+		return
+	}
+	msg := rules.ProtoReflect()
+	kind := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("type"))
+	if kind == nil {
+		return
+	}
+	typed := msg.Get(kind).Message()
+	switch kind.Name() {
+	case "repeated":
+		repeatedRules := rules.GetRepeated()
+		if repeatedRules.HasMinItems() {
+			schema.MinItems = repeatedRules.GetMinItems()
+		}
+		if repeatedRules.HasMaxItems() {
+			schema.MaxItems = repeatedRules.GetMaxItems()
+		}
+		schema.UniqueItems = repeatedRules.GetUnique()
+		if schema.Items != nil {
+			applyRules(schema.Items, field, repeatedRules.GetItems())
+		}
+	case "map":
+		mapRules := rules.GetMap()
+		if mapRules.HasMinPairs() {
+			schema.MinProperties = mapRules.GetMinPairs()
+		}
+		if mapRules.HasMaxPairs() {
+			schema.MaxProperties = mapRules.GetMaxPairs()
+		}
+		if schema.AdditionalProperties != nil {
+			applyRules(schema.AdditionalProperties, field.MapValue(), mapRules.GetValues())
+		}
+	case "enum":
+		applyEnumRules(schema, field.Enum(), rules.GetEnum())
+	case "string":
+		applyKeywords(schema, typed, stringKeywords)
+		if wellKnown := typed.WhichOneof(typed.Descriptor().Oneofs().ByName("well_known")); wellKnown != nil {
+			schema.Format = stringFormats[wellKnown.Name()]
+		}
+	case "bool", "float", "double", "int32", "sint32", "sfixed32", "uint32", "fixed32":
+		// the rules of 64-bit integers are skipped, their JSON values are strings
+		applyKeywords(schema, typed, numberKeywords)
+	}
+}
+
+// applyKeywords sets the keywords of the rules that are set, and the in and
+// not_in rules as enums
+func applyKeywords(schema *Schema, rules protoreflect.Message, keywords map[protoreflect.Name]func(*Schema, any)) {
+	rules.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch field.Name() {
+		case "in":
+			schema.Enum = listValues(value.List())
+		case "not_in":
+			schema.Not = &Schema{Enum: listValues(value.List())}
+		default:
+			if set, ok := keywords[field.Name()]; ok {
+				set(schema, value.Interface())
+			}
+		}
+		return true
+	})
+}
+
+// applyEnumRules restricts the values of the enum schema to the names of the allowed values
+func applyEnumRules(schema *Schema, enum protoreflect.EnumDescriptor, rules *validate.EnumRules) {
+	names := func(numbers []int32) []any {
+		var names []any
+		for _, number := range numbers {
+			if value := enum.Values().ByNumber(protoreflect.EnumNumber(number)); value != nil {
+				names = append(names, string(value.Name()))
+			}
+		}
+		return names
+	}
+	if name := names([]int32{rules.GetConst()}); rules.HasConst() && len(name) > 0 {
+		schema.Const = name[0]
+	}
+	if len(rules.GetIn()) > 0 {
+		schema.Enum = names(rules.GetIn())
+	}
+	if len(rules.GetNotIn()) > 0 {
+		schema.Not = &Schema{Enum: names(rules.GetNotIn())}
+	}
+}
+
+func listValues(list protoreflect.List) []any {
+	values := make([]any, 0, list.Len())
+	for i := range list.Len() {
+		values = append(values, list.Get(i).Interface())
+	}
+	return values
+}
@@ -0,0 +1,158 @@
+package openapi
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// refPrefix is the prefix of the references to the component schemas
+const refPrefix = "#/components/schemas/"
+
+// wellKnownSchemas are the schemas of the well-known types with a special JSON mapping
+var wellKnownSchemas = map[protoreflect.FullName]Schema{
+	"google.protobuf.Timestamp":   {Type: "string", Format: "date-time"},
+	"google.protobuf.Duration":    {Type: "string", Pattern: `^-?[0-9]+(\.[0-9]+)?s$`},
+	"google.protobuf.FieldMask":   {Type: "string"},
+	"google.protobuf.Empty":       {Type: "object"},
+	"google.protobuf.Struct":      {Type: "object"},
+	"google.protobuf.Value":       {},
+	"google.protobuf.ListValue":   {Type: "array"},
+	"google.protobuf.Any":         {Type: "object", Properties: map[string]*Schema{"@type": {Type: "string"}}, Required: []string{"@type"}},
+	"google.protobuf.BoolValue":   {Type: "boolean"},
+	"google.protobuf.StringValue": {Type: "string"},
+	"google.protobuf.BytesValue":  {Type: "string", Format: "byte"},
+	"google.protobuf.Int32Value":  {Type: "integer", Format: "int32"},
+	"google.protobuf.UInt32Value": {Type: "integer", Format: "int64", Minimum: 0},
+	"google.protobuf.Int64Value":  {Type: "string", Format: "int64"},
+	"google.protobuf.UInt64Value": {Type: "string", Format: "uint64"},
+	"google.protobuf.FloatValue":  {Type: "number", Format: "float"},
+	"google.protobuf.DoubleValue": {Type: "number", Format: "double"},
+}
+
+func ref(name protoreflect.FullName) string {
+	return refPrefix + string(name)
+}
+
+// messageSchema returns the schema of the message, inlined for well-known types
+func (g *generator) messageSchema(msg protoreflect.MessageDescriptor) *Schema {
+	if schema, ok := wellKnownSchemas[msg.FullName()]; ok {
+		return &schema
+	}
+	g.addMessage(msg)
+	return &Schema{Ref: ref(msg.FullName())}
+}
+
+// addMessage adds the schema of the message and of the messages and enums of its fields
+func (g *generator) addMessage(msg protoreflect.MessageDescriptor) {
+	name := string(msg.FullName())
+	if _, ok := g.schemas[name]; ok {
+		return
+	}
+	schema := &Schema{Type: "object", Description: comments(msg), Properties: map[string]*Schema{}}
+	// added before its fields, for recursive messages
+	g.schemas[name] = schema
+	if cel := messageRules(msg).GetCel(); len(cel) > 0 {
+		lines := []string{schema.Description}
+		for _, rule := range cel {
+			lines = append(lines, "- "+rule.GetMessage())
+		}
+		schema.Description = strings.TrimSpace(strings.Join(lines, "\n"))
+	}
+
+	fields := msg.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		schema.Properties[string(field.Name())] = g.fieldSchema(field)
+		if fieldRules(field).GetRequired() {
+			schema.Required = append(schema.Required, string(field.Name()))
+		}
+	}
+	oneofs := msg.Oneofs()
+	for i := range oneofs.Len() {
+		oneof := oneofs.Get(i)
+		if oneof.IsSynthetic() || !oneofRules(oneof).GetRequired() {
+			continue
+		}
+		// exactly one of the fields must be set
+		schema.OneOf = make([]*Schema, 0, oneof.Fields().Len())
+		for j := range oneof.Fields().Len() {
+			schema.OneOf = append(schema.OneOf, &Schema{Required: []string{string(oneof.Fields().Get(j).Name())}})
+		}
+	}
+}
+
+// addEnum adds the schema of the enum, with the names of its values
+func (g *generator) addEnum(enum protoreflect.EnumDescriptor) {
+	name := string(enum.FullName())
+	if _, ok := g.schemas[name]; ok {
+		return
+	}
+	schema := &Schema{Type: "string", Description: comments(enum)}
+	values := enum.Values()
+	for i := range values.Len() {
+		schema.Enum = append(schema.Enum, string(values.Get(i).Name()))
+	}
+	g.schemas[name] = schema
+}
+
+// fieldSchema returns the schema of the field with its rules
+func (g *generator) fieldSchema(field protoreflect.FieldDescriptor) *Schema {
+	var schema *Schema
+	switch {
+	case field.IsMap():
+		schema = &Schema{Type: "object", AdditionalProperties: g.valueSchema(field.MapValue())}
+	case field.IsList():
+		schema = &Schema{Type: "array", Items: g.valueSchema(field)}
+	default:
+		schema = g.valueSchema(field)
+	}
+	rules := fieldRules(field)
+	applyRules(schema, field, rules)
+	if field.IsList() && rules.GetRequired() && schema.MinItems == nil {
+		schema.MinItems = 1
+	}
+	schema.Description = comments(field)
+	return schema
+}
+
+// valueSchema returns the schema of a single value of the field
+func (g *generator) valueSchema(field protoreflect.FieldDescriptor) *Schema {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return &Schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &Schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: "integer", Format: "int64", Minimum: 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// 64-bit integers are JSON strings
+		return &Schema{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &Schema{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &Schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &Schema{Type: "number", Format: "double"}
+	case protoreflect.BytesKind:
+		return &Schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		g.addEnum(field.Enum())
+		return &Schema{Ref: ref(field.Enum().FullName())}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return g.messageSchema(field.Message())
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+// isObject reports whether the values of the schema, or its items, are JSON objects
+func (g *generator) isObject(schema *Schema) bool {
+	if schema.Items != nil {
+		return g.isObject(schema.Items)
+	}
+	if schema.Ref != "" {
+		schema = g.schemas[strings.TrimPrefix(schema.Ref, refPrefix)]
+	}
+	return schema.Type == "object"
+}
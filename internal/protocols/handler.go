@@ -0,0 +1,41 @@
+package protocols
+
+import (
+	"net/http"
+	"strings"
+
+	"connectrpc.com/vanguard"
+	"connectrpc.com/vanguard/vanguardgrpc"
+	"google.golang.org/grpc"
+)
+
+// NewHandler returns a handler serving native gRPC, gRPC-Web and the Connect
+// protocol over HTTP/1.1 and HTTP/2 with the services of grpcServer. gRPC-Web
+// and Connect requests are translated into gRPC requests by vanguard, so all
+// the protocols go through the interceptors of the server. Requests that are
+// not calls of a procedure, such as /pb.Blogger/GetBlog, are served by
+// fallback, when it is not nil.
+func NewHandler(grpcServer *grpc.Server, fallback http.Handler) (http.Handler, error) {
+	if fallback == nil {
+		fallback = http.NotFoundHandler()
+	}
+	transcoder, err := vanguardgrpc.NewTranscoder(grpcServer, vanguard.WithUnknownHandler(fallback))
+	if err != nil {
+		return nil, err
+	}
+	rpc := grpcWebText(transcoder)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the REST bindings are only served by the gateway
+		if !isProcedure(r.URL.Path) {
+			fallback.ServeHTTP(w, r)
+			return
+		}
+		rpc.ServeHTTP(w, r)
+	}), nil
+}
+
+// isProcedure reports whether the path is a procedure such as /pb.Blogger/GetBlog
+func isProcedure(path string) bool {
+	service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return ok && strings.Contains(service, ".") && method != "" && !strings.Contains(method, "/")
+}
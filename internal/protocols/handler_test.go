@@ -0,0 +1,220 @@
+package protocols
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/internal/validation"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/pb/pbconnect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeBlogger returns the blog 1, with the editor of the request as body
+type fakeBlogger struct {
+	pb.UnimplementedBloggerServer
+}
+
+func (fakeBlogger) GetBlog(ctx context.Context, req *pb.GetBlogRequest) (*pb.GetBlogResponse, error) {
+	if req.GetId() != 1 {
+		return nil, status.Error(codes.NotFound, "blog not found")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-header", "header"))
+	_ = grpc.SetTrailer(ctx, metadata.Pairs("x-trailer", "trailer"))
+	return &pb.GetBlogResponse{Item: &pb.Blog{Id: 1, Title: "hello", Body: strings.Join(md.Get("editor"), ",")}}, nil
+}
+
+func (fakeBlogger) ExportBlogs(req *pb.ExportBlogsRequest, stream grpc.ServerStreamingServer[pb.Blog]) error {
+	for id := range uint32(2) {
+		if err := stream.Send(&pb.Blog{Id: id + 1}); err != nil {
+			return err
+		}
+	}
+	return status.Error(codes.Aborted, "export interrupted")
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	validator, err := protovalidate.New()
+	assert.NoError(t, err)
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(validation.UnaryServerInterceptor(validator)),
+		grpc.StreamInterceptor(validation.StreamServerInterceptor(validator)),
+	)
+	pb.RegisterBloggerServer(s, fakeBlogger{})
+	fallback := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	handler, err := NewHandler(s, fallback)
+	assert.NoError(t, err)
+	srv := httptest.NewUnstartedServer(handler)
+	srv.Config.Protocols = new(http.Protocols)
+	srv.Config.Protocols.SetHTTP1(true)
+	srv.Config.Protocols.SetUnencryptedHTTP2(true)
+	srv.Start()
+	return srv
+}
+
+// testClients returns a client of the server for every protocol
+func testClients(srv *httptest.Server) map[string]pbconnect.BloggerClient {
+	h2c := &http.Client{Transport: &http.Transport{Protocols: new(http.Protocols)}}
+	h2c.Transport.(*http.Transport).Protocols.SetUnencryptedHTTP2(true)
+	return map[string]pbconnect.BloggerClient{
+		"grpc":         pbconnect.NewBloggerClient(h2c, srv.URL, connect.WithGRPC()),
+		"grpc-web":     pbconnect.NewBloggerClient(srv.Client(), srv.URL, connect.WithGRPCWeb()),
+		"connect":      pbconnect.NewBloggerClient(srv.Client(), srv.URL),
+		"connect+json": pbconnect.NewBloggerClient(srv.Client(), srv.URL, connect.WithProtoJSON()),
+	}
+}
+
+func TestHandlerProtocols(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	for name, client := range testClients(srv) {
+		t.Run(name, func(t *testing.T) {
+			ctx, call := connect.NewClientContext(context.Background())
+			call.RequestHeader().Set("Editor", "ada")
+			res, err := client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: 1}})
+			assert.NoError(t, err)
+			assert.Equal(t, "hello", res.GetItem().GetTitle())
+			assert.Equal(t, "ada", res.GetItem().GetBody())
+			assert.Equal(t, "header", call.ResponseHeader().Get("X-Header"))
+			assert.Equal(t, "trailer", call.ResponseTrailer().Get("X-Trailer"))
+
+			_, err = client.GetBlog(context.Background(), &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: 2}})
+			assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			var connectErr *connect.Error
+			assert.ErrorAs(t, err, &connectErr)
+			assert.Equal(t, "blog not found", connectErr.Message())
+
+			// requests are validated the same way on every protocol
+			_, err = client.GetBlog(context.Background(), &pb.GetBlogRequest{})
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			assert.Contains(t, err.Error(), "validation failed")
+
+			// server streams end with the status of the call
+			stream, err := client.ExportBlogs(context.Background(), &pb.ExportBlogsRequest{})
+			assert.NoError(t, err)
+			var ids []uint32
+			for stream.Receive() {
+				ids = append(ids, stream.Msg().GetId())
+			}
+			assert.Equal(t, []uint32{1, 2}, ids)
+			assert.Equal(t, connect.CodeAborted, connect.CodeOf(stream.Err()))
+			assert.NoError(t, stream.Close())
+		})
+	}
+}
+
+func TestHandlerConnectUnary(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	post := func(path string, body string, header http.Header) (*http.Response, []byte) {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+path, strings.NewReader(body))
+		assert.NoError(t, err)
+		req.Header = header
+		req.Header.Set("Content-Type", "application/json")
+		res, err := srv.Client().Do(req)
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		return res, data
+	}
+	connectHeader := func() http.Header {
+		return http.Header{"Connect-Protocol-Version": {"1"}}
+	}
+
+	res, body := post("/pb.Blogger/GetBlog", `{"id": 1}`, connectHeader())
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+	assert.Equal(t, "header", res.Header.Get("X-Header"))
+	assert.Equal(t, "trailer", res.Header.Get("Trailer-X-Trailer"))
+	var got pb.GetBlogResponse
+	assert.NoError(t, protojson.Unmarshal(body, &got))
+	assert.Equal(t, "hello", got.GetItem().GetTitle())
+
+	res, body = post("/pb.Blogger/GetBlog", `{"id": 2}`, connectHeader())
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	assert.JSONEq(t, `{"code": "not_found", "message": "blog not found"}`, string(body))
+
+	// timeouts too long for the gRPC timeout format are sent in a coarser unit
+	header := connectHeader()
+	header.Set("Connect-Timeout-Ms", "9999999999")
+	res, _ = post("/pb.Blogger/GetBlog", `{"id": 1}`, header)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	// other requests are served by the fallback
+	res, _ = post("/v1/blogs", `{}`, http.Header{})
+	assert.Equal(t, http.StatusTeapot, res.StatusCode)
+}
+
+func TestHandlerGRPCWebText(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	msg, err := proto.Marshal(&pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: 1}})
+	assert.NoError(t, err)
+	// the prefix and the message of the frame are sent as two padded chunks
+	frame := grpcWebFrame(0, msg)
+	body := base64.StdEncoding.EncodeToString(frame[:5]) + base64.StdEncoding.EncodeToString(frame[5:])
+	res, err := srv.Client().Post(srv.URL+"/pb.Blogger/GetBlog", "application/grpc-web-text", strings.NewReader(body))
+	assert.NoError(t, err)
+	encoded, _ := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.True(t, strings.HasPrefix(res.Header.Get("Content-Type"), "application/grpc-web-text"))
+
+	data, err := io.ReadAll(&base64Reader{r: bytes.NewReader(encoded)})
+	assert.NoError(t, err)
+	var frames [][]byte
+	var trailers string
+	for len(data) >= 5 {
+		size := binary.BigEndian.Uint32(data[1:5])
+		if data[0]&0x80 != 0 {
+			trailers = string(data[5 : 5+size])
+		} else {
+			frames = append(frames, data[5:5+size])
+		}
+		data = data[5+size:]
+	}
+	assert.Len(t, frames, 1)
+	var got pb.GetBlogResponse
+	assert.NoError(t, proto.Unmarshal(frames[0], &got))
+	assert.Equal(t, "hello", got.GetItem().GetTitle())
+	assert.Contains(t, strings.ToLower(trailers), "grpc-status: 0")
+}
+
+func TestBase64Reader(t *testing.T) {
+	for _, encoded := range []string{"aGVsbG8gd29ybGQ=", "aGVsbG8=IHdvcmxk", "aGU=bGw=byB3b3JsZA=="} {
+		decoded, err := io.ReadAll(&base64Reader{r: strings.NewReader(encoded)})
+		assert.NoError(t, err)
+		assert.Equal(t, "hello world", string(decoded))
+	}
+	_, err := io.ReadAll(&base64Reader{r: strings.NewReader("aGVsbG8")})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+// grpcWebFrame returns the message in a gRPC-Web frame with the flags
+func grpcWebFrame(flags byte, msg []byte) []byte {
+	frame := make([]byte, 5, 5+len(msg))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	return append(frame, msg...)
+}
@@ -0,0 +1,112 @@
+package protocols
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	grpcWebType     = "application/grpc-web"
+	grpcWebTextType = "application/grpc-web-text"
+)
+
+// grpcWebText serves the gRPC-Web requests whose bodies are base64 encoded,
+// which vanguard doesn't support, as binary gRPC-Web requests
+func grpcWebText(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := r.Header.Get("Content-Type")
+		if !strings.HasPrefix(contentType, grpcWebTextType) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		req := r.Clone(r.Context())
+		req.Header.Set("Content-Type", grpcWebType+strings.TrimPrefix(contentType, grpcWebTextType))
+		req.Header.Del("Content-Length")
+		req.ContentLength = -1
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{&base64Reader{r: r.Body}, r.Body}
+		next.ServeHTTP(&textWriter{ResponseWriter: w}, req)
+	})
+}
+
+// base64Reader decodes a base64 stream made of padded chunks, as sent by the
+// clients encoding every message on its own. The 4 bytes quanta of base64
+// are decoded one by one, since padding can end any of them.
+type base64Reader struct {
+	r       io.Reader
+	buf     [4096]byte
+	encoded []byte
+	decoded []byte
+	err     error
+}
+
+func (b *base64Reader) Read(p []byte) (int, error) {
+	for len(b.decoded) == 0 {
+		if b.err != nil {
+			if b.err == io.EOF && len(b.encoded) > 0 {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, b.err
+		}
+		var n int
+		n, b.err = b.r.Read(b.buf[:])
+		b.encoded = append(b.encoded, b.buf[:n]...)
+		for len(b.encoded) >= 4 {
+			var quantum [3]byte
+			n, err := base64.StdEncoding.Decode(quantum[:], b.encoded[:4])
+			if err != nil {
+				b.err = err
+				break
+			}
+			b.decoded = append(b.decoded, quantum[:n]...)
+			b.encoded = b.encoded[4:]
+		}
+	}
+	n := copy(p, b.decoded)
+	b.decoded = b.decoded[n:]
+	return n, nil
+}
+
+// textWriter base64 encodes the gRPC-Web response of a text request
+type textWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *textWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		header := w.Header()
+		if contentType := header.Get("Content-Type"); strings.HasPrefix(contentType, grpcWebType) {
+			header.Set("Content-Type", grpcWebTextType+strings.TrimPrefix(contentType, grpcWebType))
+		}
+		header.Del("Content-Length")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write encodes every write as a padded base64 chunk, which clients decode on its own
+func (w *textWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if _, err := io.WriteString(w.ResponseWriter, base64.StdEncoding.EncodeToString(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *textWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the response writer for http.ResponseController
+func (w *textWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
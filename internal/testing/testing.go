@@ -2,23 +2,26 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
 	"testing"
 
 	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/stretchr/testify/assert"
 	"github.com/susana-garcia/go-crud/config"
+	"github.com/susana-garcia/go-crud/internal/protocols"
+	"github.com/susana-garcia/go-crud/internal/validation"
 	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/pb/pbconnect"
 	"github.com/susana-garcia/go-crud/service"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
-	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
 
@@ -32,9 +35,17 @@ var database = config.Database{
 	Password: config.GetEnv("DB_PASSWORD", "gocrudtest"),
 }
 
+// PageTokenSecret is the page token secret of the services under test
+var PageTokenSecret = []byte("test page token secret")
+
 type TestEnv struct {
-	Client      pb.BloggerClient
-	CancelFuncs []func()
+	Client         pb.BloggerClient
+	CommentsClient pb.CommentsClient
+	// Conn is the connection of the clients, to serve other protocols such as the gateway
+	Conn grpc.ClientConnInterface
+	// ProtocolClients call the server through the protocols handler, by protocol name
+	ProtocolClients map[string]pbconnect.BloggerClient
+	CancelFuncs     []func()
 }
 
 func (te *TestEnv) Cancel() {
@@ -53,17 +64,10 @@ func NewTestEnvWithRegistration(ctx context.Context, t *testing.T, register func
 		os.Exit(1)
 	}
 
-	// create gRPC server with validation interceptor
+	// create gRPC server with validation interceptors
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-			// validate request using protovalidate if it's a protobuf message
-			if msg, ok := req.(proto.Message); ok {
-				if err := validator.Validate(msg); err != nil {
-					return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
-				}
-			}
-			return handler(ctx, req)
-		}),
+		grpc.UnaryInterceptor(validation.UnaryServerInterceptor(validator)),
+		grpc.StreamInterceptor(validation.StreamServerInterceptor(validator)),
 	)
 	register(s)
 	lis := bufconn.Listen(bufSize)
@@ -77,13 +81,46 @@ func NewTestEnvWithRegistration(ctx context.Context, t *testing.T, register func
 	conn, err := grpcConn(ctx, lis)
 	assert.NoError(t, err)
 
+	// serve the same server with the protocols handler, as main does
+	handler, err := protocols.NewHandler(s, nil)
+	assert.NoError(t, err)
+	httpLis := bufconn.Listen(bufSize)
+	httpServer := &http.Server{
+		Handler:   handler,
+		Protocols: new(http.Protocols),
+	}
+	httpServer.Protocols.SetHTTP1(true)
+	httpServer.Protocols.SetUnencryptedHTTP2(true)
+	go func() {
+		if err := httpServer.Serve(httpLis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			t.Logf("HTTP server exited with error: %v\n", err)
+			os.Exit(1)
+		}
+	}()
+
+	dial := func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return httpLis.DialContext(ctx)
+	}
+	httpClient := &http.Client{Transport: &http.Transport{DialContext: dial}}
+	h2cClient := &http.Client{Transport: &http.Transport{DialContext: dial, Protocols: new(http.Protocols)}}
+	h2cClient.Transport.(*http.Transport).Protocols.SetUnencryptedHTTP2(true)
+
 	client := pb.NewBloggerClient(conn)
 
 	return &TestEnv{
 		CancelFuncs: []func(){
 			func() { _ = conn.Close() },
+			func() { _ = httpServer.Close() },
+		},
+		Client:         client,
+		CommentsClient: pb.NewCommentsClient(conn),
+		Conn:           conn,
+		ProtocolClients: map[string]pbconnect.BloggerClient{
+			"grpc":         pbconnect.NewBloggerClient(h2cClient, "http://bufnet", connect.WithGRPC()),
+			"grpc-web":     pbconnect.NewBloggerClient(httpClient, "http://bufnet", connect.WithGRPCWeb()),
+			"connect":      pbconnect.NewBloggerClient(httpClient, "http://bufnet"),
+			"connect+json": pbconnect.NewBloggerClient(httpClient, "http://bufnet", connect.WithProtoJSON()),
 		},
-		Client: client,
 	}
 }
 
@@ -106,8 +143,8 @@ func SetupDatabase(logger *slog.Logger) (*gorm.DB, error) {
 	db := config.OpenConnection(database)
 
 	// run DB migration
-	logger.Info("running database migration for blogs table")
-	err := db.AutoMigrate(&service.Blog{})
+	logger.Info("running database migration")
+	err := service.AutoMigrate(db, service.DefaultSearchLanguage)
 	if err != nil {
 		logger.Error("error running auto migrate", "err", err)
 		os.Exit(1)
@@ -119,7 +156,12 @@ func SetupDatabase(logger *slog.Logger) (*gorm.DB, error) {
 
 // CleanUpDatabaseEntries deletes previous entries
 func CleanUpDatabaseEntries(db *gorm.DB, logger *slog.Logger) error {
-	tx := db.Exec("DELETE FROM blogs")
-	logger.Info("deleted", "rows", tx.RowsAffected)
-	return tx.Error
+	for _, table := range []string{"idempotency_keys", "blog_revisions", "blog_slugs", "blog_tags", "comments", "reactions", "reaction_counts", "blog_views", "tags", "blogs", "authors", "blog_changes"} {
+		tx := db.Exec("DELETE FROM " + table)
+		if tx.Error != nil {
+			return tx.Error
+		}
+		logger.Info("deleted", "table", table, "rows", tx.RowsAffected)
+	}
+	return nil
 }
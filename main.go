@@ -2,25 +2,42 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"buf.build/go/protovalidate"
 	"github.com/susana-garcia/go-crud/config"
+	"github.com/susana-garcia/go-crud/internal/gateway"
+	"github.com/susana-garcia/go-crud/internal/health"
+	"github.com/susana-garcia/go-crud/internal/openapi"
+	"github.com/susana-garcia/go-crud/internal/protocols"
+	"github.com/susana-garcia/go-crud/internal/validation"
+	"github.com/susana-garcia/go-crud/pb"
 	"github.com/susana-garcia/go-crud/server"
 	"github.com/susana-garcia/go-crud/service"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
 )
 
 //go:generate ./scripts/generate-pb.sh
 
 func main() {
+	// exit with an error once everything is cleaned up, when the server stopped because of one
+	exitCode := 0
+	defer func() {
+		if exitCode != 0 {
+			os.Exit(exitCode)
+		}
+	}()
+
 	// load configuration from environment variables
 	cfg := config.Load()
 	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
@@ -41,18 +58,26 @@ func main() {
 	logger.Info("connecting to database", "name", cfg.Name)
 
 	db := config.OpenConnection(cfg.Database)
-
-	// run DB migration
-	logger.Info("running database migration for blogs table")
-	err = db.AutoMigrate(&service.Blog{})
+	sqlDB, err := db.DB()
 	if err != nil {
-		logger.Error("error running auto migrate", "err", err)
+		logger.Error("unable to return database", "error", err)
 		os.Exit(1)
 	}
-	logger.Info("database migration completed successfully")
 
-	bService := service.New(db, logger)
-	server := server.New(bService, logger)
+	opts := []service.Option{
+		service.WithIdempotencyKeyTTL(cfg.IdempotencyKeyTTL),
+		service.WithSearchLanguage(cfg.SearchLanguage),
+		service.WithWatchBufferSize(cfg.WatchBufferSize),
+		service.WithChangeRetention(cfg.ChangeRetention),
+		service.WithDeletedRetention(cfg.DeletedRetention),
+		service.WithPublishInterval(cfg.PublishInterval),
+		service.WithViewFlushInterval(cfg.ViewFlushInterval),
+		service.WithPageTokenSecret([]byte(cfg.PageTokenSecret)),
+	}
+	bService := service.New(db, logger, opts...)
+
+	blogServer := server.New(bService, logger)
+	commentServer := server.NewCommentServer(bService, logger)
 
 	// create protovalidate validator
 	validator, err := protovalidate.New()
@@ -61,27 +86,169 @@ func main() {
 		os.Exit(1)
 	}
 
-	// create gRPC server with validation interceptor
+	// create gRPC server with validation interceptors
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-			// validate request using protovalidate if it's a protobuf message
-			if msg, ok := req.(proto.Message); ok {
-				if err := validator.Validate(msg); err != nil {
-					return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
-				}
-			}
-			return handler(ctx, req)
-		}),
+		grpc.UnaryInterceptor(validation.UnaryServerInterceptor(validator)),
+		grpc.StreamInterceptor(validation.StreamServerInterceptor(validator)),
 	)
-	server.Register(s)
+	blogServer.Register(s)
+	commentServer.Register(s)
+
+	// background workers run until the server is shut down
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	// serve the health service, not serving until the migration is done and while the database is down
+	checker := health.New(sqlDB, logger, []string{pb.Blogger_ServiceDesc.ServiceName, pb.Comments_ServiceDesc.ServiceName},
+		health.WithPingInterval(cfg.PingInterval))
+	checker.Register(s)
+	go checker.Run(workersCtx)
 
 	// enable server reflection so tools like grpcurl can discover services without a proto file
 	reflection.Register(s)
 
-	logger.Info(fmt.Sprintf("server listening on %s", address))
+	// serve the REST/JSON gateway, calling the gRPC server so requests go through its interceptors
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error("unable to create gateway client", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	gw, err := gateway.New(context.Background(), conn, gateway.WithForwardedHeaders(server.IdempotencyKeyHeader, server.EditorHeader))
+	if err != nil {
+		logger.Error("unable to register gateway routes", "error", err)
+		os.Exit(1)
+	}
+	// serve the OpenAPI document of the gateway next to it
+	mux := http.NewServeMux()
+	mux.Handle("/", gw)
+	mux.Handle("GET /openapi.json", openapi.Handler())
+	if cfg.OpenAPIViewer {
+		mux.Handle("GET /docs", openapi.ViewerHandler())
+	}
+	gatewayServer := &http.Server{
+		Addr:    fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.GatewayPort),
+		Handler: mux,
+	}
+	go func() {
+		logger.Info(fmt.Sprintf("gateway listening on %s", gatewayServer.Addr))
+		if err := gatewayServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("unable to start gateway", "error", err)
+		}
+	}()
 
-	err = s.Serve(listener)
+	// serve the liveness and readiness probes as plain HTTP requests
+	probes := http.NewServeMux()
+	probes.Handle("GET /livez", health.LivezHandler())
+	probes.Handle("GET /readyz", checker.ReadyzHandler())
+	// serve native gRPC, gRPC-Web and the Connect protocol next to the probes
+	handler, err := protocols.NewHandler(s, probes)
 	if err != nil {
+		logger.Error("unable to create protocols handler", "error", err)
+		os.Exit(1)
+	}
+
+	// run DB migration while serving, so that the health service reports it,
+	// a failed migration shuts the server down
+	workersDone := make(chan struct{})
+	migrateErr := make(chan error, 1)
+	go func() {
+		defer close(workersDone)
+		logger.Info("running database migration")
+		if err := service.AutoMigrate(db, cfg.SearchLanguage); err != nil {
+			migrateErr <- err
+			return
+		}
+		logger.Info("database migration completed successfully")
+		checker.SetMigrated()
+
+		// run background workers such as the blog changes listener, once their tables exist
+		if workersCtx.Err() == nil {
+			bService.Run(workersCtx)
+		}
+	}()
+
+	// serve all the protocols on the same listener, over HTTP/1.1 and h2c
+	httpServer := &http.Server{
+		Handler:   handler,
+		Protocols: new(http.Protocols),
+	}
+	httpServer.Protocols.SetHTTP1(true)
+	httpServer.Protocols.SetUnencryptedHTTP2(true)
+
+	// shut down on SIGINT or SIGTERM, a second signal stops the process right away
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		logger.Info(fmt.Sprintf("server listening on %s", address))
+		serveErr <- httpServer.Serve(listener)
+	}()
+	select {
+	case err := <-serveErr:
 		logger.Error("unable to start server", "error", err)
+		exitCode = 1
+	case err := <-migrateErr:
+		logger.Error("error running auto migrate", "err", err)
+		exitCode = 1
+	case <-ctx.Done():
+		stop()
+	}
+
+	start := time.Now()
+	logger.Info("shutting down", "pre_stop_delay", cfg.ShutdownDelay, "timeout", cfg.ShutdownTimeout)
+	// report NOT_SERVING first, so that load balancers stop sending requests before the connections are drained
+	checker.Shutdown()
+	time.Sleep(cfg.ShutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	graceful := stopServers(shutdownCtx, logger, s, gatewayServer, httpServer, bService)
+
+	// stop the background workers, which flush the buffered views before returning
+	stopWorkers()
+	workersStopped := true
+	select {
+	case <-workersDone:
+	case <-time.After(cfg.ShutdownTimeout):
+		logger.Warn("background workers did not stop in time")
+		workersStopped = false
+	}
+
+	dbErr := sqlDB.Close()
+	if dbErr != nil {
+		logger.Error("unable to close database", "error", dbErr)
+	}
+	logger.Info("shutdown completed",
+		"duration", time.Since(start).Round(time.Millisecond),
+		"graceful", graceful,
+		"workers_stopped", workersStopped,
+		"database_closed", dbErr == nil,
+	)
+}
+
+// stopServers ends the WatchBlogs streams, drains the gateway and then the
+// server until the deadline of ctx, and closes their remaining connections once
+// it is exceeded. It reports whether all requests were done before the deadline.
+func stopServers(ctx context.Context, logger *slog.Logger, s *grpc.Server, gatewayServer *http.Server, httpServer *http.Server, bService *service.Service) bool {
+	// watch streams never finish on their own, their clients resume elsewhere
+	bService.StopWatchers()
+	// the gateway calls the server, so it is drained first
+	err := gatewayServer.Shutdown(ctx)
+	if err == nil {
+		err = httpServer.Shutdown(ctx)
+	}
+	if err != nil {
+		logger.Warn("shutdown deadline exceeded, closing remaining connections", "error", err)
+		_ = gatewayServer.Close()
+		_ = httpServer.Close()
+		s.Stop()
+		return false
 	}
+	// RPCs are served by the HTTP server, so none are left once it is drained
+	s.GracefulStop()
+	return true
 }
@@ -8,9 +8,12 @@ package pb
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,13 +27,289 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BlogStatus int32
+
+const (
+	BlogStatus_BLOG_STATUS_UNSPECIFIED BlogStatus = 0
+	BlogStatus_BLOG_STATUS_DRAFT       BlogStatus = 1
+	// BLOG_STATUS_SCHEDULED blogs are published at their publish_at time
+	BlogStatus_BLOG_STATUS_SCHEDULED BlogStatus = 2
+	BlogStatus_BLOG_STATUS_PUBLISHED BlogStatus = 3
+	BlogStatus_BLOG_STATUS_ARCHIVED  BlogStatus = 4
+)
+
+// Enum value maps for BlogStatus.
+var (
+	BlogStatus_name = map[int32]string{
+		0: "BLOG_STATUS_UNSPECIFIED",
+		1: "BLOG_STATUS_DRAFT",
+		2: "BLOG_STATUS_SCHEDULED",
+		3: "BLOG_STATUS_PUBLISHED",
+		4: "BLOG_STATUS_ARCHIVED",
+	}
+	BlogStatus_value = map[string]int32{
+		"BLOG_STATUS_UNSPECIFIED": 0,
+		"BLOG_STATUS_DRAFT":       1,
+		"BLOG_STATUS_SCHEDULED":   2,
+		"BLOG_STATUS_PUBLISHED":   3,
+		"BLOG_STATUS_ARCHIVED":    4,
+	}
+)
+
+func (x BlogStatus) Enum() *BlogStatus {
+	p := new(BlogStatus)
+	*p = x
+	return p
+}
+
+func (x BlogStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlogStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_proto_enumTypes[0].Descriptor()
+}
+
+func (BlogStatus) Type() protoreflect.EnumType {
+	return &file_blog_proto_enumTypes[0]
+}
+
+func (x BlogStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlogStatus.Descriptor instead.
+func (BlogStatus) EnumDescriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{0}
+}
+
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_TYPE_UNSPECIFIED ChangeType = 0
+	ChangeType_CHANGE_TYPE_CREATED     ChangeType = 1
+	ChangeType_CHANGE_TYPE_UPDATED     ChangeType = 2
+	ChangeType_CHANGE_TYPE_DELETED     ChangeType = 3
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_CREATED",
+		2: "CHANGE_TYPE_UPDATED",
+		3: "CHANGE_TYPE_DELETED",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_CREATED":     1,
+		"CHANGE_TYPE_UPDATED":     2,
+		"CHANGE_TYPE_DELETED":     3,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_proto_enumTypes[1].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_blog_proto_enumTypes[1]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{1}
+}
+
+type RevisionAction int32
+
+const (
+	RevisionAction_REVISION_ACTION_UNSPECIFIED RevisionAction = 0
+	RevisionAction_REVISION_ACTION_CREATED     RevisionAction = 1
+	RevisionAction_REVISION_ACTION_UPDATED     RevisionAction = 2
+	RevisionAction_REVISION_ACTION_DELETED     RevisionAction = 3
+	RevisionAction_REVISION_ACTION_UNDELETED   RevisionAction = 4
+)
+
+// Enum value maps for RevisionAction.
+var (
+	RevisionAction_name = map[int32]string{
+		0: "REVISION_ACTION_UNSPECIFIED",
+		1: "REVISION_ACTION_CREATED",
+		2: "REVISION_ACTION_UPDATED",
+		3: "REVISION_ACTION_DELETED",
+		4: "REVISION_ACTION_UNDELETED",
+	}
+	RevisionAction_value = map[string]int32{
+		"REVISION_ACTION_UNSPECIFIED": 0,
+		"REVISION_ACTION_CREATED":     1,
+		"REVISION_ACTION_UPDATED":     2,
+		"REVISION_ACTION_DELETED":     3,
+		"REVISION_ACTION_UNDELETED":   4,
+	}
+)
+
+func (x RevisionAction) Enum() *RevisionAction {
+	p := new(RevisionAction)
+	*p = x
+	return p
+}
+
+func (x RevisionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevisionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_proto_enumTypes[2].Descriptor()
+}
+
+func (RevisionAction) Type() protoreflect.EnumType {
+	return &file_blog_proto_enumTypes[2]
+}
+
+func (x RevisionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevisionAction.Descriptor instead.
+func (RevisionAction) EnumDescriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{2}
+}
+
+type ReactionType int32
+
+const (
+	ReactionType_REACTION_TYPE_UNSPECIFIED ReactionType = 0
+	ReactionType_REACTION_TYPE_LIKE        ReactionType = 1
+	ReactionType_REACTION_TYPE_LOVE        ReactionType = 2
+	ReactionType_REACTION_TYPE_LAUGH       ReactionType = 3
+	ReactionType_REACTION_TYPE_INSIGHTFUL  ReactionType = 4
+	ReactionType_REACTION_TYPE_CELEBRATE   ReactionType = 5
+)
+
+// Enum value maps for ReactionType.
+var (
+	ReactionType_name = map[int32]string{
+		0: "REACTION_TYPE_UNSPECIFIED",
+		1: "REACTION_TYPE_LIKE",
+		2: "REACTION_TYPE_LOVE",
+		3: "REACTION_TYPE_LAUGH",
+		4: "REACTION_TYPE_INSIGHTFUL",
+		5: "REACTION_TYPE_CELEBRATE",
+	}
+	ReactionType_value = map[string]int32{
+		"REACTION_TYPE_UNSPECIFIED": 0,
+		"REACTION_TYPE_LIKE":        1,
+		"REACTION_TYPE_LOVE":        2,
+		"REACTION_TYPE_LAUGH":       3,
+		"REACTION_TYPE_INSIGHTFUL":  4,
+		"REACTION_TYPE_CELEBRATE":   5,
+	}
+)
+
+func (x ReactionType) Enum() *ReactionType {
+	p := new(ReactionType)
+	*p = x
+	return p
+}
+
+func (x ReactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_proto_enumTypes[3].Descriptor()
+}
+
+func (ReactionType) Type() protoreflect.EnumType {
+	return &file_blog_proto_enumTypes[3]
+}
+
+func (x ReactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReactionType.Descriptor instead.
+func (ReactionType) EnumDescriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{3}
+}
+
+type DiffLine_Operation int32
+
+const (
+	DiffLine_OPERATION_UNSPECIFIED DiffLine_Operation = 0
+	DiffLine_OPERATION_EQUAL       DiffLine_Operation = 1
+	DiffLine_OPERATION_INSERT      DiffLine_Operation = 2
+	DiffLine_OPERATION_DELETE      DiffLine_Operation = 3
+)
+
+// Enum value maps for DiffLine_Operation.
+var (
+	DiffLine_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_EQUAL",
+		2: "OPERATION_INSERT",
+		3: "OPERATION_DELETE",
+	}
+	DiffLine_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_EQUAL":       1,
+		"OPERATION_INSERT":      2,
+		"OPERATION_DELETE":      3,
+	}
+)
+
+func (x DiffLine_Operation) Enum() *DiffLine_Operation {
+	p := new(DiffLine_Operation)
+	*p = x
+	return p
+}
+
+func (x DiffLine_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffLine_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_proto_enumTypes[4].Descriptor()
+}
+
+func (DiffLine_Operation) Type() protoreflect.EnumType {
+	return &file_blog_proto_enumTypes[4]
+}
+
+func (x DiffLine_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffLine_Operation.Descriptor instead.
+func (DiffLine_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{37, 0}
+}
+
 type GetBlogRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Value:
 	//
 	//	*GetBlogRequest_Id
 	//	*GetBlogRequest_Title
-	Value         isGetBlogRequest_Value `protobuf_oneof:"value"`
+	//	*GetBlogRequest_Slug
+	Value isGetBlogRequest_Value `protobuf_oneof:"value"`
+	// count_view records a view of the blog, as RecordView does
+	CountView     bool `protobuf:"varint,4,opt,name=count_view,json=countView,proto3" json:"count_view,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +369,22 @@ func (x *GetBlogRequest) GetTitle() string {
 	return ""
 }
 
+func (x *GetBlogRequest) GetSlug() string {
+	if x != nil {
+		if x, ok := x.Value.(*GetBlogRequest_Slug); ok {
+			return x.Slug
+		}
+	}
+	return ""
+}
+
+func (x *GetBlogRequest) GetCountView() bool {
+	if x != nil {
+		return x.CountView
+	}
+	return false
+}
+
 type isGetBlogRequest_Value interface {
 	isGetBlogRequest_Value()
 }
@@ -102,17 +397,39 @@ type GetBlogRequest_Title struct {
 	Title string `protobuf:"bytes,2,opt,name=title,proto3,oneof"`
 }
 
+type GetBlogRequest_Slug struct {
+	// slug is the current or a former slug of the blog
+	Slug string `protobuf:"bytes,3,opt,name=slug,proto3,oneof"`
+}
+
 func (*GetBlogRequest_Id) isGetBlogRequest_Value() {}
 
 func (*GetBlogRequest_Title) isGetBlogRequest_Value() {}
 
+func (*GetBlogRequest_Slug) isGetBlogRequest_Value() {}
+
 type Blog struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body      string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// etag identifies the current version of the blog, it changes on every update
+	Etag string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	// deleted_at is set on deleted blogs until they are purged
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Status    BlogStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=pb.BlogStatus" json:"status,omitempty"`
+	// publish_at is when the blog was or is scheduled to be published
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// slug is the unique URL safe name of the blog, it changes with the title
+	Slug string `protobuf:"bytes,10,opt,name=slug,proto3" json:"slug,omitempty"`
+	// tags are the normalized names of the tags of the blog, sorted by name
+	Tags []string `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	// author is set when the blog has an author
+	Author *AuthorSummary `protobuf:"bytes,12,opt,name=author,proto3" json:"author,omitempty"`
+	// reactions count the reactions of every type the blog has, sorted by type
+	Reactions     []*ReactionCount `protobuf:"bytes,13,rep,name=reactions,proto3" json:"reactions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,9 +499,68 @@ func (x *Blog) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Blog) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Blog) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Blog) GetStatus() BlogStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+func (x *Blog) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *Blog) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Blog) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Blog) GetAuthor() *AuthorSummary {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Blog) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
 type GetBlogResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *Blog                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Item  *Blog                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// moved is set when the blog was found by a former slug, item.slug is the
+	// slug it should be redirected to
+	Moved         bool `protobuf:"varint,2,opt,name=moved,proto3" json:"moved,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -226,11 +602,31 @@ func (x *GetBlogResponse) GetItem() *Blog {
 	return nil
 }
 
+func (x *GetBlogResponse) GetMoved() bool {
+	if x != nil {
+		return x.Moved
+	}
+	return false
+}
+
 type GetBlogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page  int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Sort  string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// page_token is the next_page_token returned with the previous page. When
+	// set, the page starts right after the last blog of the previous page.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// filter restricts the blogs returned, using the AIP-160 syntax, e.g.
+	// title:"go" AND created_at > "2026-01-01T00:00:00Z"
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// statuses restricts the blogs returned to the given statuses, only
+	// published blogs are returned when empty
+	Statuses []BlogStatus `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=pb.BlogStatus" json:"statuses,omitempty"`
+	// tag restricts the blogs returned to the ones with the tag
+	Tag string `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	// author_id restricts the blogs returned to the ones of the author
+	AuthorId      uint32 `protobuf:"varint,8,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -286,14 +682,55 @@ func (x *GetBlogsRequest) GetSort() string {
 	return ""
 }
 
+func (x *GetBlogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetBlogsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetBlogsRequest) GetStatuses() []BlogStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetBlogsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetBlogsRequest) GetAuthorId() uint32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
 type GetBlogsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Blog                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
-	TotalItems    int32                  `protobuf:"varint,5,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,6,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Items  []*Blog                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page   int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Sort   string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Filter string                 `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// total_items and total_pages count the blogs matching the filter, they are
+	// only set when paging by page number
+	TotalItems int64 `protobuf:"varint,5,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages int32 `protobuf:"varint,6,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// next_page_token can be sent as page_token to get the next page, it is
+	// empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -356,7 +793,14 @@ func (x *GetBlogsResponse) GetSort() string {
 	return ""
 }
 
-func (x *GetBlogsResponse) GetTotalItems() int32 {
+func (x *GetBlogsResponse) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetBlogsResponse) GetTotalItems() int64 {
 	if x != nil {
 		return x.TotalItems
 	}
@@ -370,10 +814,25 @@ func (x *GetBlogsResponse) GetTotalPages() int32 {
 	return 0
 }
 
+func (x *GetBlogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type CreateBlogRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body  string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// status of the new blog, either draft or published. Blogs are published
+	// when it is not set, use PublishBlog to schedule a draft.
+	Status BlogStatus `protobuf:"varint,3,opt,name=status,proto3,enum=pb.BlogStatus" json:"status,omitempty"`
+	// tags are lower cased and their spaces replaced with dashes, tags that
+	// do not exist yet are created
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// author_id is the id of an existing author, blogs can have no author
+	AuthorId      uint32 `protobuf:"varint,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -422,6 +881,27 @@ func (x *CreateBlogRequest) GetBody() string {
 	return ""
 }
 
+func (x *CreateBlogRequest) GetStatus() BlogStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlogStatus_BLOG_STATUS_UNSPECIFIED
+}
+
+func (x *CreateBlogRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateBlogRequest) GetAuthorId() uint32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
 type CreateBlogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -467,10 +947,22 @@ func (x *CreateBlogResponse) GetId() uint32 {
 }
 
 type UpdateBlogRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Body  *string                `protobuf:"bytes,3,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	// update_mask lists the fields to update. When set, masked fields that are
+	// not present in the request are cleared. When not set, only the fields
+	// present in the request are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// etag of the blog as last read by the caller. When set, the update fails
+	// with ABORTED if the blog has been modified since.
+	Etag string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	// tags replace the tags of the blog. Without an update mask they are only
+	// updated when not empty, use an update mask with tags to remove all tags.
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// author_id is the id of an existing author, 0 removes the author
+	AuthorId      *uint32 `protobuf:"varint,7,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -513,22 +1005,53 @@ func (x *UpdateBlogRequest) GetId() uint32 {
 }
 
 func (x *UpdateBlogRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
 func (x *UpdateBlogRequest) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *UpdateBlogRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Body
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateBlogRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
 	}
 	return ""
 }
 
+func (x *UpdateBlogRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateBlogRequest) GetAuthorId() uint32 {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return 0
+}
+
 type DeleteBlogRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// etag of the blog as last read by the caller. When set, the delete fails
+	// with ABORTED if the blog has been modified since.
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -570,16 +1093,3481 @@ func (x *DeleteBlogRequest) GetId() uint32 {
 	return 0
 }
 
+func (x *DeleteBlogRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type SearchBlogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query uses the web search syntax, e.g. go -rust "error handling"
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBlogsRequest) Reset() {
+	*x = SearchBlogsRequest{}
+	mi := &file_blog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBlogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlogsRequest) ProtoMessage() {}
+
+func (x *SearchBlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchBlogsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchBlogsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchBlogsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchBlogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchBlogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Item  *Blog                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Rank  float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// title_highlight and body_highlight are fragments of the title and body
+	// with the matching words wrapped in <b></b>
+	TitleHighlight string `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	BodyHighlight  string `protobuf:"bytes,4,opt,name=body_highlight,json=bodyHighlight,proto3" json:"body_highlight,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_blog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchResult) GetItem() *Blog {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *SearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchResult) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchResult) GetBodyHighlight() string {
+	if x != nil {
+		return x.BodyHighlight
+	}
+	return ""
+}
+
+type SearchBlogsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Limit   int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page    int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// total_items and total_pages are only set when paging by page number
+	TotalItems    int64  `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	NextPageToken string `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchBlogsResponse) Reset() {
+	*x = SearchBlogsResponse{}
+	mi := &file_blog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchBlogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlogsResponse) ProtoMessage() {}
+
+func (x *SearchBlogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlogsResponse.ProtoReflect.Descriptor instead.
+func (*SearchBlogsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchBlogsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchBlogsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchBlogsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchBlogsResponse) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *SearchBlogsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *SearchBlogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchGetBlogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetBlogsRequest) Reset() {
+	*x = BatchGetBlogsRequest{}
+	mi := &file_blog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetBlogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetBlogsRequest) ProtoMessage() {}
+
+func (x *BatchGetBlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetBlogsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetBlogsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchGetBlogsRequest) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetBlogResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item is set when the blog was found, status is NOT_FOUND otherwise
+	Item          *Blog          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Status        *status.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetBlogResult) Reset() {
+	*x = BatchGetBlogResult{}
+	mi := &file_blog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetBlogResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetBlogResult) ProtoMessage() {}
+
+func (x *BatchGetBlogResult) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetBlogResult.ProtoReflect.Descriptor instead.
+func (*BatchGetBlogResult) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchGetBlogResult) GetItem() *Blog {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *BatchGetBlogResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type BatchGetBlogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results are in the same order as the requested ids
+	Results       []*BatchGetBlogResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetBlogsResponse) Reset() {
+	*x = BatchGetBlogsResponse{}
+	mi := &file_blog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetBlogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetBlogsResponse) ProtoMessage() {}
+
+func (x *BatchGetBlogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetBlogsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetBlogsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchGetBlogsResponse) GetResults() []*BatchGetBlogResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchCreateBlogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// requests are validated one by one, the rules of CreateBlogRequest apply
+	// to every item
+	Requests []*CreateBlogRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// all_or_nothing creates no blogs when any of them fails. Otherwise the
+	// valid blogs are created and the failures are reported per item.
+	AllOrNothing  bool `protobuf:"varint,2,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateBlogsRequest) Reset() {
+	*x = BatchCreateBlogsRequest{}
+	mi := &file_blog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateBlogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateBlogsRequest) ProtoMessage() {}
+
+func (x *BatchCreateBlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateBlogsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateBlogsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchCreateBlogsRequest) GetRequests() []*CreateBlogRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *BatchCreateBlogsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchCreateBlogResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is set when the blog was created, status holds the error otherwise
+	Id            uint32         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        *status.Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateBlogResult) Reset() {
+	*x = BatchCreateBlogResult{}
+	mi := &file_blog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateBlogResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateBlogResult) ProtoMessage() {}
+
+func (x *BatchCreateBlogResult) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateBlogResult.ProtoReflect.Descriptor instead.
+func (*BatchCreateBlogResult) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchCreateBlogResult) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchCreateBlogResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type BatchCreateBlogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results are in the same order as the requests
+	Results       []*BatchCreateBlogResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateBlogsResponse) Reset() {
+	*x = BatchCreateBlogsResponse{}
+	mi := &file_blog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateBlogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateBlogsResponse) ProtoMessage() {}
+
+func (x *BatchCreateBlogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateBlogsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateBlogsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchCreateBlogsResponse) GetResults() []*BatchCreateBlogResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDeleteBlogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// all_or_nothing deletes no blogs when any of them fails, e.g. because it
+	// does not exist. Otherwise the failures are reported per item.
+	AllOrNothing  bool `protobuf:"varint,2,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteBlogsRequest) Reset() {
+	*x = BatchDeleteBlogsRequest{}
+	mi := &file_blog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteBlogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteBlogsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteBlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteBlogsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteBlogsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchDeleteBlogsRequest) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteBlogsRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+type BatchDeleteBlogResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status is OK when the blog was deleted
+	Status        *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteBlogResult) Reset() {
+	*x = BatchDeleteBlogResult{}
+	mi := &file_blog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteBlogResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteBlogResult) ProtoMessage() {}
+
+func (x *BatchDeleteBlogResult) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteBlogResult.ProtoReflect.Descriptor instead.
+func (*BatchDeleteBlogResult) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchDeleteBlogResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type BatchDeleteBlogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results are in the same order as the requested ids
+	Results       []*BatchDeleteBlogResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteBlogsResponse) Reset() {
+	*x = BatchDeleteBlogsResponse{}
+	mi := &file_blog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteBlogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteBlogsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteBlogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteBlogsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteBlogsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchDeleteBlogsResponse) GetResults() []*BatchDeleteBlogResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WatchBlogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// after_sequence resumes watching after the change with that sequence,
+	// e.g. the last one received before reconnecting. When not set, only the
+	// changes made from now on are sent.
+	AfterSequence uint64 `protobuf:"varint,1,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	// filter restricts the changes sent to the blogs matching it, using the
+	// same syntax as GetBlogsRequest.filter
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// types restricts the changes sent to these types, all types are sent when empty
+	Types         []ChangeType `protobuf:"varint,3,rep,packed,name=types,proto3,enum=pb.ChangeType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBlogsRequest) Reset() {
+	*x = WatchBlogsRequest{}
+	mi := &file_blog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBlogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBlogsRequest) ProtoMessage() {}
+
+func (x *WatchBlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBlogsRequest.ProtoReflect.Descriptor instead.
+func (*WatchBlogsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WatchBlogsRequest) GetAfterSequence() uint64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+func (x *WatchBlogsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *WatchBlogsRequest) GetTypes() []ChangeType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type BlogChange struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type     ChangeType             `protobuf:"varint,2,opt,name=type,proto3,enum=pb.ChangeType" json:"type,omitempty"`
+	// item is the blog after the change, or before it when deleted
+	Item          *Blog                  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogChange) Reset() {
+	*x = BlogChange{}
+	mi := &file_blog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogChange) ProtoMessage() {}
+
+func (x *BlogChange) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogChange.ProtoReflect.Descriptor instead.
+func (*BlogChange) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BlogChange) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *BlogChange) GetType() ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return ChangeType_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *BlogChange) GetItem() *Blog {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *BlogChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ImportedBlog struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// source_id identifies the blog in the source being imported. Blogs that
+	// were already imported with the same source_id are skipped, so an
+	// interrupted import can be resumed by sending the source again.
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Title    string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body     string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// created_at and updated_at preserve the original timestamps of the blog,
+	// the time of the import is used when they are not set
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedBlog) Reset() {
+	*x = ImportedBlog{}
+	mi := &file_blog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedBlog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedBlog) ProtoMessage() {}
+
+func (x *ImportedBlog) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedBlog.ProtoReflect.Descriptor instead.
+func (*ImportedBlog) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportedBlog) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ImportedBlog) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportedBlog) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ImportedBlog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportedBlog) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ImportBlogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// blog is validated on its own so that invalid blogs are reported without
+	// failing the import
+	Blog          *ImportedBlog `protobuf:"bytes,1,opt,name=blog,proto3" json:"blog,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBlogsRequest) Reset() {
+	*x = ImportBlogsRequest{}
+	mi := &file_blog_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBlogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBlogsRequest) ProtoMessage() {}
+
+func (x *ImportBlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBlogsRequest.ProtoReflect.Descriptor instead.
+func (*ImportBlogsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportBlogsRequest) GetBlog() *ImportedBlog {
+	if x != nil {
+		return x.Blog
+	}
+	return nil
+}
+
+type ImportError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index is the position of the blog in the stream, starting at 0
+	Index         int32          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	SourceId      string         `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Status        *status.Status `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportError) Reset() {
+	*x = ImportError{}
+	mi := &file_blog_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportError) ProtoMessage() {}
+
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportError) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ImportError) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ImportBlogsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Inserted int32                  `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Skipped  int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed   int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// errors details the failed blogs, up to the first 1000
+	Errors        []*ImportError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportBlogsResponse) Reset() {
+	*x = ImportBlogsResponse{}
+	mi := &file_blog_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBlogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBlogsResponse) ProtoMessage() {}
+
+func (x *ImportBlogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBlogsResponse.ProtoReflect.Descriptor instead.
+func (*ImportBlogsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportBlogsResponse) GetInserted() int32 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *ImportBlogsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportBlogsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportBlogsResponse) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExportBlogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter selects the exported blogs, with the same syntax as GetBlogsRequest.filter
+	Filter        string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBlogsRequest) Reset() {
+	*x = ExportBlogsRequest{}
+	mi := &file_blog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBlogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBlogsRequest) ProtoMessage() {}
+
+func (x *ExportBlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBlogsRequest.ProtoReflect.Descriptor instead.
+func (*ExportBlogsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportBlogsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type UndeleteBlogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndeleteBlogRequest) Reset() {
+	*x = UndeleteBlogRequest{}
+	mi := &file_blog_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndeleteBlogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteBlogRequest) ProtoMessage() {}
+
+func (x *UndeleteBlogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteBlogRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteBlogRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UndeleteBlogRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListDeletedBlogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedBlogsRequest) Reset() {
+	*x = ListDeletedBlogsRequest{}
+	mi := &file_blog_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedBlogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedBlogsRequest) ProtoMessage() {}
+
+func (x *ListDeletedBlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedBlogsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedBlogsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListDeletedBlogsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeletedBlogsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListDeletedBlogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items are the deleted blogs, the most recently deleted first
+	Items         []*Blog `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Limit         int32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalItems    int64   `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32   `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedBlogsResponse) Reset() {
+	*x = ListDeletedBlogsResponse{}
+	mi := &file_blog_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedBlogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedBlogsResponse) ProtoMessage() {}
+
+func (x *ListDeletedBlogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedBlogsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedBlogsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListDeletedBlogsResponse) GetItems() []*Blog {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListDeletedBlogsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeletedBlogsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedBlogsResponse) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListDeletedBlogsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type BlogRevision struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BlogId uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// revision numbers the revisions of a blog starting at 1
+	Revision uint32         `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Action   RevisionAction `protobuf:"varint,3,opt,name=action,proto3,enum=pb.RevisionAction" json:"action,omitempty"`
+	Title    string         `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body     string         `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	// editor is the editor header sent with the request that made the revision
+	Editor        string                 `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlogRevision) Reset() {
+	*x = BlogRevision{}
+	mi := &file_blog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlogRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlogRevision) ProtoMessage() {}
+
+func (x *BlogRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlogRevision.ProtoReflect.Descriptor instead.
+func (*BlogRevision) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BlogRevision) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *BlogRevision) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *BlogRevision) GetAction() RevisionAction {
+	if x != nil {
+		return x.Action
+	}
+	return RevisionAction_REVISION_ACTION_UNSPECIFIED
+}
+
+func (x *BlogRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BlogRevision) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *BlogRevision) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *BlogRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListBlogRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlogId        uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlogRevisionsRequest) Reset() {
+	*x = ListBlogRevisionsRequest{}
+	mi := &file_blog_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlogRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlogRevisionsRequest) ProtoMessage() {}
+
+func (x *ListBlogRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlogRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlogRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListBlogRevisionsRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *ListBlogRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBlogRevisionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListBlogRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items are the revisions of the blog, the most recent first
+	Items         []*BlogRevision `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Limit         int32           `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32           `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalItems    int64           `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32           `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlogRevisionsResponse) Reset() {
+	*x = ListBlogRevisionsResponse{}
+	mi := &file_blog_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlogRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlogRevisionsResponse) ProtoMessage() {}
+
+func (x *ListBlogRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlogRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlogRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListBlogRevisionsResponse) GetItems() []*BlogRevision {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListBlogRevisionsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBlogRevisionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBlogRevisionsResponse) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListBlogRevisionsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type GetBlogRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlogId        uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	Revision      uint32                 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogRevisionRequest) Reset() {
+	*x = GetBlogRevisionRequest{}
+	mi := &file_blog_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogRevisionRequest) ProtoMessage() {}
+
+func (x *GetBlogRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetBlogRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetBlogRevisionRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *GetBlogRevisionRequest) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RestoreBlogRevisionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	BlogId   uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	Revision uint32                 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// etag of the blog as last read by the caller. When set, the restore fails
+	// with ABORTED if the blog has been modified since.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBlogRevisionRequest) Reset() {
+	*x = RestoreBlogRevisionRequest{}
+	mi := &file_blog_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBlogRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBlogRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreBlogRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBlogRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreBlogRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RestoreBlogRevisionRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *RestoreBlogRevisionRequest) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RestoreBlogRevisionRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DiffBlogRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlogId        uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	FromRevision  uint32                 `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision    uint32                 `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffBlogRevisionsRequest) Reset() {
+	*x = DiffBlogRevisionsRequest{}
+	mi := &file_blog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffBlogRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffBlogRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffBlogRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffBlogRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffBlogRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DiffBlogRevisionsRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *DiffBlogRevisionsRequest) GetFromRevision() uint32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffBlogRevisionsRequest) GetToRevision() uint32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type DiffLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     DiffLine_Operation     `protobuf:"varint,1,opt,name=operation,proto3,enum=pb.DiffLine_Operation" json:"operation,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_blog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DiffLine) GetOperation() DiffLine_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return DiffLine_OPERATION_UNSPECIFIED
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DiffBlogRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title and body are the lines of the from revision that were kept or
+	// deleted, and the lines of the to revision that were inserted
+	Title         []*DiffLine `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty"`
+	Body          []*DiffLine `protobuf:"bytes,2,rep,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffBlogRevisionsResponse) Reset() {
+	*x = DiffBlogRevisionsResponse{}
+	mi := &file_blog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffBlogRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffBlogRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffBlogRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffBlogRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffBlogRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DiffBlogRevisionsResponse) GetTitle() []*DiffLine {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *DiffBlogRevisionsResponse) GetBody() []*DiffLine {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type PublishBlogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// publish_at schedules the blog to be published later, it is published
+	// right away when not set or in the past
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// etag of the blog as last read by the caller. When set, publishing fails
+	// with ABORTED if the blog has been modified since.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishBlogRequest) Reset() {
+	*x = PublishBlogRequest{}
+	mi := &file_blog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishBlogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishBlogRequest) ProtoMessage() {}
+
+func (x *PublishBlogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishBlogRequest.ProtoReflect.Descriptor instead.
+func (*PublishBlogRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PublishBlogRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PublishBlogRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *PublishBlogRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UnpublishBlogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// archive archives the blog instead of turning it back into a draft
+	Archive bool `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	// etag of the blog as last read by the caller. When set, unpublishing
+	// fails with ABORTED if the blog has been modified since.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishBlogRequest) Reset() {
+	*x = UnpublishBlogRequest{}
+	mi := &file_blog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishBlogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishBlogRequest) ProtoMessage() {}
+
+func (x *UnpublishBlogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishBlogRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishBlogRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnpublishBlogRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UnpublishBlogRequest) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+func (x *UnpublishBlogRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// blog_count is the number of published blogs with the tag
+	BlogCount     int64 `protobuf:"varint,2,opt,name=blog_count,json=blogCount,proto3" json:"blog_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_blog_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetBlogCount() int64 {
+	if x != nil {
+		return x.BlogCount
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_blog_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTagsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items are the tags, the most used first
+	Items         []*Tag `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalItems    int64  `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_blog_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListTagsResponse) GetItems() []*Tag {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTagsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTagsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTagsResponse) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListTagsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type RenameTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// new_name must not be used by another tag, merge the tags instead
+	NewName       string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_blog_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type MergeTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sources are the tags merged into the target, they are deleted once
+	// their blogs are tagged with the target
+	Sources []string `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// target is created when it does not exist
+	Target        string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_blog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MergeTagsRequest) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type Author struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// handle is the unique name of the author
+	Handle        string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_blog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Author) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Author) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Author) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Author) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Author) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Author) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AuthorSummary is the part of the author embedded in blogs
+type AuthorSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Handle        string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorSummary) Reset() {
+	*x = AuthorSummary{}
+	mi := &file_blog_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorSummary) ProtoMessage() {}
+
+func (x *AuthorSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorSummary.ProtoReflect.Descriptor instead.
+func (*AuthorSummary) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AuthorSummary) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuthorSummary) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *AuthorSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthorSummary) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type CreateAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthorRequest) Reset() {
+	*x = CreateAuthorRequest{}
+	mi := &file_blog_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorRequest) ProtoMessage() {}
+
+func (x *CreateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateAuthorRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type CreateAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthorResponse) Reset() {
+	*x = CreateAuthorResponse{}
+	mi := &file_blog_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorResponse) ProtoMessage() {}
+
+func (x *CreateAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorResponse.ProtoReflect.Descriptor instead.
+func (*CreateAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateAuthorResponse) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAuthorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*GetAuthorRequest_Id
+	//	*GetAuthorRequest_Handle
+	Value         isGetAuthorRequest_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorRequest) Reset() {
+	*x = GetAuthorRequest{}
+	mi := &file_blog_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorRequest) ProtoMessage() {}
+
+func (x *GetAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetAuthorRequest) GetValue() isGetAuthorRequest_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetAuthorRequest) GetId() uint32 {
+	if x != nil {
+		if x, ok := x.Value.(*GetAuthorRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+func (x *GetAuthorRequest) GetHandle() string {
+	if x != nil {
+		if x, ok := x.Value.(*GetAuthorRequest_Handle); ok {
+			return x.Handle
+		}
+	}
+	return ""
+}
+
+type isGetAuthorRequest_Value interface {
+	isGetAuthorRequest_Value()
+}
+
+type GetAuthorRequest_Id struct {
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type GetAuthorRequest_Handle struct {
+	Handle string `protobuf:"bytes,2,opt,name=handle,proto3,oneof"`
+}
+
+func (*GetAuthorRequest_Id) isGetAuthorRequest_Value() {}
+
+func (*GetAuthorRequest_Handle) isGetAuthorRequest_Value() {}
+
+type GetAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Author                `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorResponse) Reset() {
+	*x = GetAuthorResponse{}
+	mi := &file_blog_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorResponse) ProtoMessage() {}
+
+func (x *GetAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetAuthorResponse) GetItem() *Author {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ListAuthorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsRequest) Reset() {
+	*x = ListAuthorsRequest{}
+	mi := &file_blog_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsRequest) ProtoMessage() {}
+
+func (x *ListAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListAuthorsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuthorsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListAuthorsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items are the authors sorted by handle
+	Items         []*Author `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Limit         int32     `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32     `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalItems    int64     `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32     `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsResponse) Reset() {
+	*x = ListAuthorsResponse{}
+	mi := &file_blog_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsResponse) ProtoMessage() {}
+
+func (x *ListAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListAuthorsResponse) GetItems() []*Author {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAuthorsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuthorsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuthorsResponse) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListAuthorsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type UpdateAuthorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// only the fields present in the request are updated, bio and avatar_url
+	// are cleared when set to an empty string
+	Handle        *string `protobuf:"bytes,2,opt,name=handle,proto3,oneof" json:"handle,omitempty"`
+	Name          *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Bio           *string `protobuf:"bytes,4,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	AvatarUrl     *string `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuthorRequest) Reset() {
+	*x = UpdateAuthorRequest{}
+	mi := &file_blog_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthorRequest) ProtoMessage() {}
+
+func (x *UpdateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateAuthorRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAuthorRequest) GetHandle() string {
+	if x != nil && x.Handle != nil {
+		return *x.Handle
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+type DeleteAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAuthorRequest) Reset() {
+	*x = DeleteAuthorRequest{}
+	mi := &file_blog_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuthorRequest) ProtoMessage() {}
+
+func (x *DeleteAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuthorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteAuthorRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReactionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ReactionType           `protobuf:"varint,1,opt,name=type,proto3,enum=pb.ReactionType" json:"type,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_blog_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReactionCount) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ReactionCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Reaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlogId        uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          ReactionType           `protobuf:"varint,3,opt,name=type,proto3,enum=pb.ReactionType" json:"type,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	mi := &file_blog_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Reaction) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *Reaction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Reaction) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Reaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddReactionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BlogId uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// user_id identifies the reader reacting, a user reacts at most once with
+	// every type so adding a reaction again has no effect
+	UserId        string       `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          ReactionType `protobuf:"varint,3,opt,name=type,proto3,enum=pb.ReactionType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_blog_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AddReactionRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *AddReactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddReactionRequest) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+type RemoveReactionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BlogId uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// removing a reaction that does not exist has no effect
+	UserId        string       `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          ReactionType `protobuf:"varint,3,opt,name=type,proto3,enum=pb.ReactionType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_blog_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RemoveReactionRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *RemoveReactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+type ListReactionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BlogId uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// type restricts the reactions listed to the ones of the type
+	Type          ReactionType `protobuf:"varint,2,opt,name=type,proto3,enum=pb.ReactionType" json:"type,omitempty"`
+	Limit         int32        `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32        `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionsRequest) Reset() {
+	*x = ListReactionsRequest{}
+	mi := &file_blog_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionsRequest) ProtoMessage() {}
+
+func (x *ListReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListReactionsRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *ListReactionsRequest) GetType() ReactionType {
+	if x != nil {
+		return x.Type
+	}
+	return ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func (x *ListReactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReactionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListReactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items are the reactions, the most recent first
+	Items         []*Reaction `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Limit         int32       `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32       `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalItems    int64       `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32       `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactionsResponse) Reset() {
+	*x = ListReactionsResponse{}
+	mi := &file_blog_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactionsResponse) ProtoMessage() {}
+
+func (x *ListReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListReactionsResponse) GetItems() []*Reaction {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListReactionsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReactionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReactionsResponse) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListReactionsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type RecordViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlogId        uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordViewRequest) Reset() {
+	*x = RecordViewRequest{}
+	mi := &file_blog_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordViewRequest) ProtoMessage() {}
+
+func (x *RecordViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordViewRequest.ProtoReflect.Descriptor instead.
+func (*RecordViewRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RecordViewRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+type GetBlogViewStatsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BlogId uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// from is the first day of the range as YYYY-MM-DD in UTC, 29 days before
+	// to when empty
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the last day of the range as YYYY-MM-DD in UTC, today when empty
+	To            string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogViewStatsRequest) Reset() {
+	*x = GetBlogViewStatsRequest{}
+	mi := &file_blog_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogViewStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogViewStatsRequest) ProtoMessage() {}
+
+func (x *GetBlogViewStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogViewStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetBlogViewStatsRequest) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetBlogViewStatsRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *GetBlogViewStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetBlogViewStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type DailyViews struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// date is the day as YYYY-MM-DD in UTC
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Views         int64  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyViews) Reset() {
+	*x = DailyViews{}
+	mi := &file_blog_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyViews) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyViews) ProtoMessage() {}
+
+func (x *DailyViews) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyViews.ProtoReflect.Descriptor instead.
+func (*DailyViews) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DailyViews) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyViews) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+type GetBlogViewStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// days are all the days of the range in order, with the days without views
+	Days          []*DailyViews `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	TotalViews    int64         `protobuf:"varint,2,opt,name=total_views,json=totalViews,proto3" json:"total_views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlogViewStatsResponse) Reset() {
+	*x = GetBlogViewStatsResponse{}
+	mi := &file_blog_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlogViewStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlogViewStatsResponse) ProtoMessage() {}
+
+func (x *GetBlogViewStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blog_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlogViewStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetBlogViewStatsResponse) Descriptor() ([]byte, []int) {
+	return file_blog_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetBlogViewStatsResponse) GetDays() []*DailyViews {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetBlogViewStatsResponse) GetTotalViews() int64 {
+	if x != nil {
+		return x.TotalViews
+	}
+	return 0
+}
+
 var File_blog_proto protoreflect.FileDescriptor
 
 const file_blog_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"blog.proto\x12\x02pb\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\\\n" +
+	"blog.proto\x12\x02pb\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x17google/rpc/status.proto\"\xb4\x01\n" +
 	"\x0eGetBlogRequest\x12\x19\n" +
 	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01H\x00R\x02id\x12\x1f\n" +
-	"\x05title\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x00R\x05titleB\x0e\n" +
-	"\x05value\x12\x05\xbaH\x02\b\x01\"\xb6\x01\n" +
+	"\x05title\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x03H\x00R\x05title\x127\n" +
+	"\x04slug\x18\x03 \x01(\tB!\xbaH\x1er\x1c\x18P2\x18^[a-z0-9]+(-[a-z0-9]+)*$H\x00R\x04slug\x12\x1d\n" +
+	"\n" +
+	"count_view\x18\x04 \x01(\bR\tcountViewB\x0e\n" +
+	"\x05value\x12\x05\xbaH\x02\b\x01\"\xec\x03\n" +
 	"\x04Blog\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -587,43 +4575,412 @@ const file_blog_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"7\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\x129\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12&\n" +
+	"\x06status\x18\b \x01(\x0e2\x0e.pb.BlogStatusR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x12\n" +
+	"\x04slug\x18\n" +
+	" \x01(\tR\x04slug\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12)\n" +
+	"\x06author\x18\f \x01(\v2\x11.pb.AuthorSummaryR\x06author\x12/\n" +
+	"\treactions\x18\r \x03(\v2\x11.pb.ReactionCountR\treactions\"M\n" +
 	"\x0fGetBlogResponse\x12$\n" +
-	"\x04item\x18\x01 \x01(\v2\b.pb.BlogB\x06\xbaH\x03\xc8\x01\x01R\x04item\"X\n" +
+	"\x04item\x18\x01 \x01(\v2\b.pb.BlogB\x06\xbaH\x03\xc8\x01\x01R\x04item\x12\x14\n" +
+	"\x05moved\x18\x02 \x01(\bR\x05moved\"\x80\x03\n" +
 	"\x0fGetBlogsRequest\x12\x1d\n" +
 	"\x05limit\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02\x10dR\x05limit\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
-	"\x04sort\x18\x03 \x01(\tR\x04sort\"\xb2\x01\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12 \n" +
+	"\x06filter\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x06filter\x12=\n" +
+	"\bstatuses\x18\x06 \x03(\x0e2\x0e.pb.BlogStatusB\x11\xbaH\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\bstatuses\x12\x19\n" +
+	"\x03tag\x18\a \x01(\tB\a\xbaH\x04r\x02\x182R\x03tag\x12\x1b\n" +
+	"\tauthor_id\x18\b \x01(\rR\bauthorId:n\xbaHk\x1ai\n" +
+	"\x12page_or_page_token\x12*page and page_token cannot be set together\x1a'this.page == 0 || this.page_token == ''\"\xf2\x01\n" +
 	"\x10GetBlogsResponse\x12\x1e\n" +
 	"\x05items\x18\x01 \x03(\v2\b.pb.BlogR\x05items\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
-	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x1f\n" +
-	"\vtotal_items\x18\x05 \x01(\x05R\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06filter\x18\b \x01(\tR\x06filter\x12\x1f\n" +
+	"\vtotal_items\x18\x05 \x01(\x03R\n" +
 	"totalItems\x12\x1f\n" +
 	"\vtotal_pages\x18\x06 \x01(\x05R\n" +
-	"totalPages\"S\n" +
+	"totalPages\x12&\n" +
+	"\x0fnext_page_token\x18\a \x01(\tR\rnextPageToken\"\xcc\x01\n" +
 	"\x11CreateBlogRequest\x12\x1f\n" +
 	"\x05title\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x03\x18\x1eR\x05title\x12\x1d\n" +
-	"\x04body\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x03\x18dR\x04body\"$\n" +
+	"\x04body\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x03\x18dR\x04body\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x0e.pb.BlogStatusB\f\xbaH\t\x82\x01\x06\x18\x00\x18\x01\x18\x03R\x06status\x12$\n" +
+	"\x04tags\x18\x04 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\n" +
+	"\"\x06r\x04\x10\x01\x182R\x04tags\x12\x1b\n" +
+	"\tauthor_id\x18\x05 \x01(\rR\bauthorId\"$\n" +
 	"\x12CreateBlogResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"\xb6\x01\n" +
-	"\x11UpdateBlogRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
-	"\x04body\x18\x03 \x01(\tR\x04body:g\xbaHd\x1ab\n" +
-	"\x12at_least_one_param\x12)At least one of title or body must be set\x1a!has(this.title) || has(this.body)\"+\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xc0\x05\n" +
+	"\x11UpdateBlogRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x02id\x12$\n" +
+	"\x05title\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x03\x18\x1eH\x00R\x05title\x88\x01\x01\x12\"\n" +
+	"\x04body\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x03\x18dH\x01R\x04body\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12&\n" +
+	"\x04etag\x18\x05 \x01(\tB\x12\xbaH\x0f\xd8\x01\x01r\n" +
+	"2\b^[0-9]+$R\x04etag\x12$\n" +
+	"\x04tags\x18\x06 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\n" +
+	"\"\x06r\x04\x10\x01\x182R\x04tags\x12 \n" +
+	"\tauthor_id\x18\a \x01(\rH\x02R\bauthorId\x88\x01\x01:\xf9\x02\xbaH\xf5\x02\x1a\xc7\x01\n" +
+	"\x12at_least_one_param\x12GAt least one of title, body, tags, author_id or update_mask must be set\x1ahhas(this.title) || has(this.body) || size(this.tags) > 0 || has(this.author_id) || has(this.update_mask)\x1a\xa8\x01\n" +
+	"\x0etitle_required\x12Ctitle cannot be cleared, it must be set when present in update_mask\x1aQ!has(this.update_mask) || !('title' in this.update_mask.paths) || has(this.title)B\b\n" +
+	"\x06_titleB\a\n" +
+	"\x05_bodyB\f\n" +
+	"\n" +
+	"_author_id\"S\n" +
 	"\x11DeleteBlogRequest\x12\x16\n" +
-	"\x02id\x18\x01 \x01(\rB\x06\xbaH\x03\xc8\x01\x01R\x02id2\xb5\x02\n" +
-	"\aBlogger\x124\n" +
-	"\aGetBlog\x12\x12.pb.GetBlogRequest\x1a\x13.pb.GetBlogResponse\"\x00\x127\n" +
-	"\bGetBlogs\x12\x13.pb.GetBlogsRequest\x1a\x14.pb.GetBlogsResponse\"\x00\x12=\n" +
+	"\x02id\x18\x01 \x01(\rB\x06\xbaH\x03\xc8\x01\x01R\x02id\x12&\n" +
+	"\x04etag\x18\x02 \x01(\tB\x12\xbaH\x0f\xd8\x01\x01r\n" +
+	"2\b^[0-9]+$R\x04etag\"\xf8\x01\n" +
+	"\x12SearchBlogsRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05query\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x10dR\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken:n\xbaHk\x1ai\n" +
+	"\x12page_or_page_token\x12*page and page_token cannot be set together\x1a'this.page == 0 || this.page_token == ''\"\x90\x01\n" +
+	"\fSearchResult\x12\x1c\n" +
+	"\x04item\x18\x01 \x01(\v2\b.pb.BlogR\x04item\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12'\n" +
+	"\x0ftitle_highlight\x18\x03 \x01(\tR\x0etitleHighlight\x12%\n" +
+	"\x0ebody_highlight\x18\x04 \x01(\tR\rbodyHighlight\"\xd5\x01\n" +
+	"\x13SearchBlogsResponse\x12*\n" +
+	"\aresults\x18\x01 \x03(\v2\x10.pb.SearchResultR\aresults\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x03R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"<\n" +
+	"\x14BatchGetBlogsRequest\x12$\n" +
+	"\x03ids\x18\x01 \x03(\rB\x12\xbaH\x0f\x92\x01\f\b\x01\x10d\x18\x01\"\x04*\x02(\x01R\x03ids\"^\n" +
+	"\x12BatchGetBlogResult\x12\x1c\n" +
+	"\x04item\x18\x01 \x01(\v2\b.pb.BlogR\x04item\x12*\n" +
+	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusR\x06status\"I\n" +
+	"\x15BatchGetBlogsResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.pb.BatchGetBlogResultR\aresults\"\x83\x01\n" +
+	"\x17BatchCreateBlogsRequest\x12B\n" +
+	"\brequests\x18\x01 \x03(\v2\x15.pb.CreateBlogRequestB\x0f\xbaH\f\x92\x01\t\b\x01\x10d\"\x03\xd8\x01\x03R\brequests\x12$\n" +
+	"\x0eall_or_nothing\x18\x02 \x01(\bR\fallOrNothing\"S\n" +
+	"\x15BatchCreateBlogResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12*\n" +
+	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusR\x06status\"O\n" +
+	"\x18BatchCreateBlogsResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.pb.BatchCreateBlogResultR\aresults\"e\n" +
+	"\x17BatchDeleteBlogsRequest\x12$\n" +
+	"\x03ids\x18\x01 \x03(\rB\x12\xbaH\x0f\x92\x01\f\b\x01\x10d\x18\x01\"\x04*\x02(\x01R\x03ids\x12$\n" +
+	"\x0eall_or_nothing\x18\x02 \x01(\bR\fallOrNothing\"C\n" +
+	"\x15BatchDeleteBlogResult\x12*\n" +
+	"\x06status\x18\x01 \x01(\v2\x12.google.rpc.StatusR\x06status\"O\n" +
+	"\x18BatchDeleteBlogsResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.pb.BatchDeleteBlogResultR\aresults\"\x95\x01\n" +
+	"\x11WatchBlogsRequest\x12%\n" +
+	"\x0eafter_sequence\x18\x01 \x01(\x04R\rafterSequence\x12 \n" +
+	"\x06filter\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x06filter\x127\n" +
+	"\x05types\x18\x03 \x03(\x0e2\x0e.pb.ChangeTypeB\x11\xbaH\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\x05types\"\xa5\x01\n" +
+	"\n" +
+	"BlogChange\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\"\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0e.pb.ChangeTypeR\x04type\x12\x1c\n" +
+	"\x04item\x18\x03 \x01(\v2\b.pb.BlogR\x04item\x129\n" +
+	"\n" +
+	"changed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\x8c\x03\n" +
+	"\fImportedBlog\x12%\n" +
+	"\tsource_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bsourceId\x12\x1f\n" +
+	"\x05title\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x03\x18\x1eR\x05title\x12\x1d\n" +
+	"\x04body\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x03\x18dR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:\x9e\x01\xbaH\x9a\x01\x1a\x97\x01\n" +
+	"\x15updated_after_created\x12(updated_at must not be before created_at\x1aT!has(this.created_at) || !has(this.updated_at) || this.updated_at >= this.created_at\"B\n" +
+	"\x12ImportBlogsRequest\x12,\n" +
+	"\x04blog\x18\x01 \x01(\v2\x10.pb.ImportedBlogB\x06\xbaH\x03\xd8\x01\x03R\x04blog\"l\n" +
+	"\vImportError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12*\n" +
+	"\x06status\x18\x03 \x01(\v2\x12.google.rpc.StatusR\x06status\"\x8c\x01\n" +
+	"\x13ImportBlogsResponse\x12\x1a\n" +
+	"\binserted\x18\x01 \x01(\x05R\binserted\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12'\n" +
+	"\x06errors\x18\x04 \x03(\v2\x0f.pb.ImportErrorR\x06errors\"6\n" +
+	"\x12ExportBlogsRequest\x12 \n" +
+	"\x06filter\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x06filter\".\n" +
+	"\x13UndeleteBlogRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x02id\"L\n" +
+	"\x17ListDeletedBlogsRequest\x12\x1d\n" +
+	"\x05limit\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02\x10dR\x05limit\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\"\xa6\x01\n" +
+	"\x18ListDeletedBlogsResponse\x12\x1e\n" +
+	"\x05items\x18\x01 \x03(\v2\b.pb.BlogR\x05items\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x03R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"\xec\x01\n" +
+	"\fBlogRevision\x12\x17\n" +
+	"\ablog_id\x18\x01 \x01(\rR\x06blogId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\rR\brevision\x12*\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x12.pb.RevisionActionR\x06action\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x16\n" +
+	"\x06editor\x18\x06 \x01(\tR\x06editor\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"o\n" +
+	"\x18ListBlogRevisionsRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02\x10dR\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\"\xaf\x01\n" +
+	"\x19ListBlogRevisionsResponse\x12&\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.pb.BlogRevisionR\x05items\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x03R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"_\n" +
+	"\x16GetBlogRevisionRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12#\n" +
+	"\brevision\x18\x02 \x01(\rB\a\xbaH\x04*\x02(\x01R\brevision\"\x8b\x01\n" +
+	"\x1aRestoreBlogRevisionRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12#\n" +
+	"\brevision\x18\x02 \x01(\rB\a\xbaH\x04*\x02(\x01R\brevision\x12&\n" +
+	"\x04etag\x18\x03 \x01(\tB\x12\xbaH\x0f\xd8\x01\x01r\n" +
+	"2\b^[0-9]+$R\x04etag\"\x94\x01\n" +
+	"\x18DiffBlogRevisionsRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12,\n" +
+	"\rfrom_revision\x18\x02 \x01(\rB\a\xbaH\x04*\x02(\x01R\ffromRevision\x12(\n" +
+	"\vto_revision\x18\x03 \x01(\rB\a\xbaH\x04*\x02(\x01R\n" +
+	"toRevision\"\xbd\x01\n" +
+	"\bDiffLine\x124\n" +
+	"\toperation\x18\x01 \x01(\x0e2\x16.pb.DiffLine.OperationR\toperation\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"g\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fOPERATION_EQUAL\x10\x01\x12\x14\n" +
+	"\x10OPERATION_INSERT\x10\x02\x12\x14\n" +
+	"\x10OPERATION_DELETE\x10\x03\"a\n" +
+	"\x19DiffBlogRevisionsResponse\x12\"\n" +
+	"\x05title\x18\x01 \x03(\v2\f.pb.DiffLineR\x05title\x12 \n" +
+	"\x04body\x18\x02 \x03(\v2\f.pb.DiffLineR\x04body\"\x90\x01\n" +
+	"\x12PublishBlogRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x02id\x129\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12&\n" +
+	"\x04etag\x18\x03 \x01(\tB\x12\xbaH\x0f\xd8\x01\x01r\n" +
+	"2\b^[0-9]+$R\x04etag\"q\n" +
+	"\x14UnpublishBlogRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x02id\x12\x18\n" +
+	"\aarchive\x18\x02 \x01(\bR\aarchive\x12&\n" +
+	"\x04etag\x18\x03 \x01(\tB\x12\xbaH\x0f\xd8\x01\x01r\n" +
+	"2\b^[0-9]+$R\x04etag\"8\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"blog_count\x18\x02 \x01(\x03R\tblogCount\"D\n" +
+	"\x0fListTagsRequest\x12\x1d\n" +
+	"\x05limit\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02\x10dR\x05limit\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\"\x9d\x01\n" +
+	"\x10ListTagsResponse\x12\x1d\n" +
+	"\x05items\x18\x01 \x03(\v2\a.pb.TagR\x05items\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x03R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"W\n" +
+	"\x10RenameTagRequest\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x04name\x12$\n" +
+	"\bnew_name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\anewName\"c\n" +
+	"\x10MergeTagsRequest\x12,\n" +
+	"\asources\x18\x01 \x03(\tB\x12\xbaH\x0f\x92\x01\f\b\x01\x10d\"\x06r\x04\x10\x01\x182R\asources\x12!\n" +
+	"\x06target\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x182R\x06target\"\xeb\x01\n" +
+	"\x06Author\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tR\tavatarUrl\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"CreateBlog\x12\x15.pb.CreateBlogRequest\x1a\x16.pb.CreateBlogResponse\"\x00\x12=\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"j\n" +
+	"\rAuthorSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
-	"UpdateBlog\x12\x15.pb.UpdateBlogRequest\x1a\x16.google.protobuf.Empty\"\x00\x12=\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"\xb1\x01\n" +
+	"\x13CreateAuthorRequest\x120\n" +
+	"\x06handle\x18\x01 \x01(\tB\x18\xbaH\x15r\x132\x11^[a-z0-9_]{3,30}$R\x06handle\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12\x1a\n" +
+	"\x03bio\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x03bio\x12-\n" +
 	"\n" +
-	"DeleteBlog\x12\x15.pb.DeleteBlogRequest\x1a\x16.google.protobuf.Empty\"\x00B\x06Z\x04./pbb\x06proto3"
+	"avatar_url\x18\x04 \x01(\tB\x0e\xbaH\v\xd8\x01\x01r\x06\x18\x80\x10\x88\x01\x01R\tavatarUrl\"&\n" +
+	"\x14CreateAuthorResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"q\n" +
+	"\x10GetAuthorRequest\x12\x19\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01H\x00R\x02id\x122\n" +
+	"\x06handle\x18\x02 \x01(\tB\x18\xbaH\x15r\x132\x11^[a-z0-9_]{3,30}$H\x00R\x06handleB\x0e\n" +
+	"\x05value\x12\x05\xbaH\x02\b\x01\";\n" +
+	"\x11GetAuthorResponse\x12&\n" +
+	"\x04item\x18\x01 \x01(\v2\n" +
+	".pb.AuthorB\x06\xbaH\x03\xc8\x01\x01R\x04item\"G\n" +
+	"\x12ListAuthorsRequest\x12\x1d\n" +
+	"\x05limit\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02\x10dR\x05limit\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\"\xa3\x01\n" +
+	"\x13ListAuthorsResponse\x12 \n" +
+	"\x05items\x18\x01 \x03(\v2\n" +
+	".pb.AuthorR\x05items\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x03R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"\xb1\x03\n" +
+	"\x13UpdateAuthorRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x02id\x125\n" +
+	"\x06handle\x18\x02 \x01(\tB\x18\xbaH\x15r\x132\x11^[a-z0-9_]{3,30}$H\x00R\x06handle\x88\x01\x01\x12\"\n" +
+	"\x04name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dH\x01R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\x03bio\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x02R\x03bio\x88\x01\x01\x122\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tB\x0e\xbaH\v\xd8\x01\x01r\x06\x18\x80\x10\x88\x01\x01H\x03R\tavatarUrl\x88\x01\x01:\xa5\x01\xbaH\xa1\x01\x1a\x9e\x01\n" +
+	"\x12at_least_one_param\x12;At least one of handle, name, bio or avatar_url must be set\x1aKhas(this.handle) || has(this.name) || has(this.bio) || has(this.avatar_url)B\t\n" +
+	"\a_handleB\a\n" +
+	"\x05_nameB\x06\n" +
+	"\x04_bioB\r\n" +
+	"\v_avatar_url\".\n" +
+	"\x13DeleteAuthorRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x02id\"K\n" +
+	"\rReactionCount\x12$\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x10.pb.ReactionTypeR\x04type\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x9d\x01\n" +
+	"\bReaction\x12\x17\n" +
+	"\ablog_id\x18\x01 \x01(\rR\x06blogId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x10.pb.ReactionTypeR\x04type\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8c\x01\n" +
+	"\x12AddReactionRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12\"\n" +
+	"\auser_id\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x06userId\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x10.pb.ReactionTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\"\x8f\x01\n" +
+	"\x15RemoveReactionRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12\"\n" +
+	"\auser_id\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x06userId\x120\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x10.pb.ReactionTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\"\x9b\x01\n" +
+	"\x14ListReactionsRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x10.pb.ReactionTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\x12\x1d\n" +
+	"\x05limit\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02\x10dR\x05limit\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\"\xa7\x01\n" +
+	"\x15ListReactionsResponse\x12\"\n" +
+	"\x05items\x18\x01 \x03(\v2\f.pb.ReactionR\x05items\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x03R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"5\n" +
+	"\x11RecordViewRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\"\xaf\x01\n" +
+	"\x17GetBlogViewStatsRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12:\n" +
+	"\x04from\x18\x02 \x01(\tB&\xbaH#r!2\x1f^([0-9]{4}-[0-9]{2}-[0-9]{2})?$R\x04from\x126\n" +
+	"\x02to\x18\x03 \x01(\tB&\xbaH#r!2\x1f^([0-9]{4}-[0-9]{2}-[0-9]{2})?$R\x02to\"6\n" +
+	"\n" +
+	"DailyViews\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05views\x18\x02 \x01(\x03R\x05views\"_\n" +
+	"\x18GetBlogViewStatsResponse\x12\"\n" +
+	"\x04days\x18\x01 \x03(\v2\x0e.pb.DailyViewsR\x04days\x12\x1f\n" +
+	"\vtotal_views\x18\x02 \x01(\x03R\n" +
+	"totalViews*\x90\x01\n" +
+	"\n" +
+	"BlogStatus\x12\x1b\n" +
+	"\x17BLOG_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11BLOG_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15BLOG_STATUS_SCHEDULED\x10\x02\x12\x19\n" +
+	"\x15BLOG_STATUS_PUBLISHED\x10\x03\x12\x18\n" +
+	"\x14BLOG_STATUS_ARCHIVED\x10\x04*t\n" +
+	"\n" +
+	"ChangeType\x12\x1b\n" +
+	"\x17CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CHANGE_TYPE_CREATED\x10\x01\x12\x17\n" +
+	"\x13CHANGE_TYPE_UPDATED\x10\x02\x12\x17\n" +
+	"\x13CHANGE_TYPE_DELETED\x10\x03*\xa7\x01\n" +
+	"\x0eRevisionAction\x12\x1f\n" +
+	"\x1bREVISION_ACTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17REVISION_ACTION_CREATED\x10\x01\x12\x1b\n" +
+	"\x17REVISION_ACTION_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17REVISION_ACTION_DELETED\x10\x03\x12\x1d\n" +
+	"\x19REVISION_ACTION_UNDELETED\x10\x04*\xb1\x01\n" +
+	"\fReactionType\x12\x1d\n" +
+	"\x19REACTION_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REACTION_TYPE_LIKE\x10\x01\x12\x16\n" +
+	"\x12REACTION_TYPE_LOVE\x10\x02\x12\x17\n" +
+	"\x13REACTION_TYPE_LAUGH\x10\x03\x12\x1c\n" +
+	"\x18REACTION_TYPE_INSIGHTFUL\x10\x04\x12\x1b\n" +
+	"\x17REACTION_TYPE_CELEBRATE\x10\x052\x83\x19\n" +
+	"\aBlogger\x12^\n" +
+	"\aGetBlog\x12\x12.pb.GetBlogRequest\x1a\x13.pb.GetBlogResponse\"*\x82\xd3\xe4\x93\x02$Z\x12\x12\x10/v1/blogs:lookup\x12\x0e/v1/blogs/{id}\x12H\n" +
+	"\bGetBlogs\x12\x13.pb.GetBlogsRequest\x1a\x14.pb.GetBlogsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/blogs\x12Q\n" +
+	"\n" +
+	"CreateBlog\x12\x15.pb.CreateBlogRequest\x1a\x16.pb.CreateBlogResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/blogs\x12V\n" +
+	"\n" +
+	"UpdateBlog\x12\x15.pb.UpdateBlogRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*2\x0e/v1/blogs/{id}\x12S\n" +
+	"\n" +
+	"DeleteBlog\x12\x15.pb.DeleteBlogRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/blogs/{id}\x12X\n" +
+	"\vSearchBlogs\x12\x16.pb.SearchBlogsRequest\x1a\x17.pb.SearchBlogsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/blogs:search\x12`\n" +
+	"\rBatchGetBlogs\x12\x18.pb.BatchGetBlogsRequest\x1a\x19.pb.BatchGetBlogsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/blogs:batchGet\x12o\n" +
+	"\x10BatchCreateBlogs\x12\x1b.pb.BatchCreateBlogsRequest\x1a\x1c.pb.BatchCreateBlogsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/blogs:batchCreate\x12o\n" +
+	"\x10BatchDeleteBlogs\x12\x1b.pb.BatchDeleteBlogsRequest\x1a\x1c.pb.BatchDeleteBlogsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/blogs:batchDelete\x127\n" +
+	"\n" +
+	"WatchBlogs\x12\x15.pb.WatchBlogsRequest\x1a\x0e.pb.BlogChange\"\x000\x01\x12B\n" +
+	"\vImportBlogs\x12\x16.pb.ImportBlogsRequest\x1a\x17.pb.ImportBlogsResponse\"\x00(\x01\x123\n" +
+	"\vExportBlogs\x12\x16.pb.ExportBlogsRequest\x1a\b.pb.Blog\"\x000\x01\x12c\n" +
+	"\fUndeleteBlog\x12\x17.pb.UndeleteBlogRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/blogs/{id}:undelete\x12l\n" +
+	"\x10ListDeletedBlogs\x12\x1b.pb.ListDeletedBlogsRequest\x1a\x1c.pb.ListDeletedBlogsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/blogs:listDeleted\x12w\n" +
+	"\x11ListBlogRevisions\x12\x1c.pb.ListBlogRevisionsRequest\x1a\x1d.pb.ListBlogRevisionsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/blogs/{blog_id}/revisions\x12q\n" +
+	"\x0fGetBlogRevision\x12\x1a.pb.GetBlogRevisionRequest\x1a\x10.pb.BlogRevision\"0\x82\xd3\xe4\x93\x02*\x12(/v1/blogs/{blog_id}/revisions/{revision}\x12\x8a\x01\n" +
+	"\x13RestoreBlogRevision\x12\x1e.pb.RestoreBlogRevisionRequest\x1a\x16.google.protobuf.Empty\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/blogs/{blog_id}/revisions/{revision}:restore\x12|\n" +
+	"\x11DiffBlogRevisions\x12\x1c.pb.DiffBlogRevisionsRequest\x1a\x1d.pb.DiffBlogRevisionsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/blogs/{blog_id}/revisions:diff\x12`\n" +
+	"\vPublishBlog\x12\x16.pb.PublishBlogRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/blogs/{id}:publish\x12f\n" +
+	"\rUnpublishBlog\x12\x18.pb.UnpublishBlogRequest\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/blogs/{id}:unpublish\x12G\n" +
+	"\bListTags\x12\x13.pb.ListTagsRequest\x1a\x14.pb.ListTagsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/tags\x12\\\n" +
+	"\tRenameTag\x12\x14.pb.RenameTagRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/tags/{name}:rename\x12T\n" +
+	"\tMergeTags\x12\x14.pb.MergeTagsRequest\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/tags:merge\x12Y\n" +
+	"\fCreateAuthor\x12\x17.pb.CreateAuthorRequest\x1a\x18.pb.CreateAuthorResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/authors\x12h\n" +
+	"\tGetAuthor\x12\x14.pb.GetAuthorRequest\x1a\x15.pb.GetAuthorResponse\".\x82\xd3\xe4\x93\x02(Z\x14\x12\x12/v1/authors:lookup\x12\x10/v1/authors/{id}\x12S\n" +
+	"\vListAuthors\x12\x16.pb.ListAuthorsRequest\x1a\x17.pb.ListAuthorsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/authors\x12\\\n" +
+	"\fUpdateAuthor\x12\x17.pb.UpdateAuthorRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/authors/{id}\x12Y\n" +
+	"\fDeleteAuthor\x12\x17.pb.DeleteAuthorRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/authors/{id}\x12g\n" +
+	"\vAddReaction\x12\x16.pb.AddReactionRequest\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/blogs/{blog_id}/reactions\x12j\n" +
+	"\x0eRemoveReaction\x12\x19.pb.RemoveReactionRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/blogs/{blog_id}/reactions\x12k\n" +
+	"\rListReactions\x12\x18.pb.ListReactionsRequest\x1a\x19.pb.ListReactionsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/blogs/{blog_id}/reactions\x12f\n" +
+	"\n" +
+	"RecordView\x12\x15.pb.RecordViewRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/blogs/{blog_id}:recordView\x12p\n" +
+	"\x10GetBlogViewStats\x12\x1b.pb.GetBlogViewStatsRequest\x1a\x1c.pb.GetBlogViewStatsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/blogs/{blog_id}/viewsB\x06Z\x04./pbb\x06proto3"
 
 var (
 	file_blog_proto_rawDescOnce sync.Once
@@ -637,40 +4994,209 @@ func file_blog_proto_rawDescGZIP() []byte {
 	return file_blog_proto_rawDescData
 }
 
-var file_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_blog_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_blog_proto_goTypes = []any{
-	(*GetBlogRequest)(nil),        // 0: pb.GetBlogRequest
-	(*Blog)(nil),                  // 1: pb.Blog
-	(*GetBlogResponse)(nil),       // 2: pb.GetBlogResponse
-	(*GetBlogsRequest)(nil),       // 3: pb.GetBlogsRequest
-	(*GetBlogsResponse)(nil),      // 4: pb.GetBlogsResponse
-	(*CreateBlogRequest)(nil),     // 5: pb.CreateBlogRequest
-	(*CreateBlogResponse)(nil),    // 6: pb.CreateBlogResponse
-	(*UpdateBlogRequest)(nil),     // 7: pb.UpdateBlogRequest
-	(*DeleteBlogRequest)(nil),     // 8: pb.DeleteBlogRequest
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+	(BlogStatus)(0),                    // 0: pb.BlogStatus
+	(ChangeType)(0),                    // 1: pb.ChangeType
+	(RevisionAction)(0),                // 2: pb.RevisionAction
+	(ReactionType)(0),                  // 3: pb.ReactionType
+	(DiffLine_Operation)(0),            // 4: pb.DiffLine.Operation
+	(*GetBlogRequest)(nil),             // 5: pb.GetBlogRequest
+	(*Blog)(nil),                       // 6: pb.Blog
+	(*GetBlogResponse)(nil),            // 7: pb.GetBlogResponse
+	(*GetBlogsRequest)(nil),            // 8: pb.GetBlogsRequest
+	(*GetBlogsResponse)(nil),           // 9: pb.GetBlogsResponse
+	(*CreateBlogRequest)(nil),          // 10: pb.CreateBlogRequest
+	(*CreateBlogResponse)(nil),         // 11: pb.CreateBlogResponse
+	(*UpdateBlogRequest)(nil),          // 12: pb.UpdateBlogRequest
+	(*DeleteBlogRequest)(nil),          // 13: pb.DeleteBlogRequest
+	(*SearchBlogsRequest)(nil),         // 14: pb.SearchBlogsRequest
+	(*SearchResult)(nil),               // 15: pb.SearchResult
+	(*SearchBlogsResponse)(nil),        // 16: pb.SearchBlogsResponse
+	(*BatchGetBlogsRequest)(nil),       // 17: pb.BatchGetBlogsRequest
+	(*BatchGetBlogResult)(nil),         // 18: pb.BatchGetBlogResult
+	(*BatchGetBlogsResponse)(nil),      // 19: pb.BatchGetBlogsResponse
+	(*BatchCreateBlogsRequest)(nil),    // 20: pb.BatchCreateBlogsRequest
+	(*BatchCreateBlogResult)(nil),      // 21: pb.BatchCreateBlogResult
+	(*BatchCreateBlogsResponse)(nil),   // 22: pb.BatchCreateBlogsResponse
+	(*BatchDeleteBlogsRequest)(nil),    // 23: pb.BatchDeleteBlogsRequest
+	(*BatchDeleteBlogResult)(nil),      // 24: pb.BatchDeleteBlogResult
+	(*BatchDeleteBlogsResponse)(nil),   // 25: pb.BatchDeleteBlogsResponse
+	(*WatchBlogsRequest)(nil),          // 26: pb.WatchBlogsRequest
+	(*BlogChange)(nil),                 // 27: pb.BlogChange
+	(*ImportedBlog)(nil),               // 28: pb.ImportedBlog
+	(*ImportBlogsRequest)(nil),         // 29: pb.ImportBlogsRequest
+	(*ImportError)(nil),                // 30: pb.ImportError
+	(*ImportBlogsResponse)(nil),        // 31: pb.ImportBlogsResponse
+	(*ExportBlogsRequest)(nil),         // 32: pb.ExportBlogsRequest
+	(*UndeleteBlogRequest)(nil),        // 33: pb.UndeleteBlogRequest
+	(*ListDeletedBlogsRequest)(nil),    // 34: pb.ListDeletedBlogsRequest
+	(*ListDeletedBlogsResponse)(nil),   // 35: pb.ListDeletedBlogsResponse
+	(*BlogRevision)(nil),               // 36: pb.BlogRevision
+	(*ListBlogRevisionsRequest)(nil),   // 37: pb.ListBlogRevisionsRequest
+	(*ListBlogRevisionsResponse)(nil),  // 38: pb.ListBlogRevisionsResponse
+	(*GetBlogRevisionRequest)(nil),     // 39: pb.GetBlogRevisionRequest
+	(*RestoreBlogRevisionRequest)(nil), // 40: pb.RestoreBlogRevisionRequest
+	(*DiffBlogRevisionsRequest)(nil),   // 41: pb.DiffBlogRevisionsRequest
+	(*DiffLine)(nil),                   // 42: pb.DiffLine
+	(*DiffBlogRevisionsResponse)(nil),  // 43: pb.DiffBlogRevisionsResponse
+	(*PublishBlogRequest)(nil),         // 44: pb.PublishBlogRequest
+	(*UnpublishBlogRequest)(nil),       // 45: pb.UnpublishBlogRequest
+	(*Tag)(nil),                        // 46: pb.Tag
+	(*ListTagsRequest)(nil),            // 47: pb.ListTagsRequest
+	(*ListTagsResponse)(nil),           // 48: pb.ListTagsResponse
+	(*RenameTagRequest)(nil),           // 49: pb.RenameTagRequest
+	(*MergeTagsRequest)(nil),           // 50: pb.MergeTagsRequest
+	(*Author)(nil),                     // 51: pb.Author
+	(*AuthorSummary)(nil),              // 52: pb.AuthorSummary
+	(*CreateAuthorRequest)(nil),        // 53: pb.CreateAuthorRequest
+	(*CreateAuthorResponse)(nil),       // 54: pb.CreateAuthorResponse
+	(*GetAuthorRequest)(nil),           // 55: pb.GetAuthorRequest
+	(*GetAuthorResponse)(nil),          // 56: pb.GetAuthorResponse
+	(*ListAuthorsRequest)(nil),         // 57: pb.ListAuthorsRequest
+	(*ListAuthorsResponse)(nil),        // 58: pb.ListAuthorsResponse
+	(*UpdateAuthorRequest)(nil),        // 59: pb.UpdateAuthorRequest
+	(*DeleteAuthorRequest)(nil),        // 60: pb.DeleteAuthorRequest
+	(*ReactionCount)(nil),              // 61: pb.ReactionCount
+	(*Reaction)(nil),                   // 62: pb.Reaction
+	(*AddReactionRequest)(nil),         // 63: pb.AddReactionRequest
+	(*RemoveReactionRequest)(nil),      // 64: pb.RemoveReactionRequest
+	(*ListReactionsRequest)(nil),       // 65: pb.ListReactionsRequest
+	(*ListReactionsResponse)(nil),      // 66: pb.ListReactionsResponse
+	(*RecordViewRequest)(nil),          // 67: pb.RecordViewRequest
+	(*GetBlogViewStatsRequest)(nil),    // 68: pb.GetBlogViewStatsRequest
+	(*DailyViews)(nil),                 // 69: pb.DailyViews
+	(*GetBlogViewStatsResponse)(nil),   // 70: pb.GetBlogViewStatsResponse
+	(*timestamppb.Timestamp)(nil),      // 71: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 72: google.protobuf.FieldMask
+	(*status.Status)(nil),              // 73: google.rpc.Status
+	(*emptypb.Empty)(nil),              // 74: google.protobuf.Empty
 }
 var file_blog_proto_depIdxs = []int32{
-	9,  // 0: pb.Blog.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 1: pb.Blog.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: pb.GetBlogResponse.item:type_name -> pb.Blog
-	1,  // 3: pb.GetBlogsResponse.items:type_name -> pb.Blog
-	0,  // 4: pb.Blogger.GetBlog:input_type -> pb.GetBlogRequest
-	3,  // 5: pb.Blogger.GetBlogs:input_type -> pb.GetBlogsRequest
-	5,  // 6: pb.Blogger.CreateBlog:input_type -> pb.CreateBlogRequest
-	7,  // 7: pb.Blogger.UpdateBlog:input_type -> pb.UpdateBlogRequest
-	8,  // 8: pb.Blogger.DeleteBlog:input_type -> pb.DeleteBlogRequest
-	2,  // 9: pb.Blogger.GetBlog:output_type -> pb.GetBlogResponse
-	4,  // 10: pb.Blogger.GetBlogs:output_type -> pb.GetBlogsResponse
-	6,  // 11: pb.Blogger.CreateBlog:output_type -> pb.CreateBlogResponse
-	10, // 12: pb.Blogger.UpdateBlog:output_type -> google.protobuf.Empty
-	10, // 13: pb.Blogger.DeleteBlog:output_type -> google.protobuf.Empty
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	71, // 0: pb.Blog.created_at:type_name -> google.protobuf.Timestamp
+	71, // 1: pb.Blog.updated_at:type_name -> google.protobuf.Timestamp
+	71, // 2: pb.Blog.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: pb.Blog.status:type_name -> pb.BlogStatus
+	71, // 4: pb.Blog.publish_at:type_name -> google.protobuf.Timestamp
+	52, // 5: pb.Blog.author:type_name -> pb.AuthorSummary
+	61, // 6: pb.Blog.reactions:type_name -> pb.ReactionCount
+	6,  // 7: pb.GetBlogResponse.item:type_name -> pb.Blog
+	0,  // 8: pb.GetBlogsRequest.statuses:type_name -> pb.BlogStatus
+	6,  // 9: pb.GetBlogsResponse.items:type_name -> pb.Blog
+	0,  // 10: pb.CreateBlogRequest.status:type_name -> pb.BlogStatus
+	72, // 11: pb.UpdateBlogRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 12: pb.SearchResult.item:type_name -> pb.Blog
+	15, // 13: pb.SearchBlogsResponse.results:type_name -> pb.SearchResult
+	6,  // 14: pb.BatchGetBlogResult.item:type_name -> pb.Blog
+	73, // 15: pb.BatchGetBlogResult.status:type_name -> google.rpc.Status
+	18, // 16: pb.BatchGetBlogsResponse.results:type_name -> pb.BatchGetBlogResult
+	10, // 17: pb.BatchCreateBlogsRequest.requests:type_name -> pb.CreateBlogRequest
+	73, // 18: pb.BatchCreateBlogResult.status:type_name -> google.rpc.Status
+	21, // 19: pb.BatchCreateBlogsResponse.results:type_name -> pb.BatchCreateBlogResult
+	73, // 20: pb.BatchDeleteBlogResult.status:type_name -> google.rpc.Status
+	24, // 21: pb.BatchDeleteBlogsResponse.results:type_name -> pb.BatchDeleteBlogResult
+	1,  // 22: pb.WatchBlogsRequest.types:type_name -> pb.ChangeType
+	1,  // 23: pb.BlogChange.type:type_name -> pb.ChangeType
+	6,  // 24: pb.BlogChange.item:type_name -> pb.Blog
+	71, // 25: pb.BlogChange.changed_at:type_name -> google.protobuf.Timestamp
+	71, // 26: pb.ImportedBlog.created_at:type_name -> google.protobuf.Timestamp
+	71, // 27: pb.ImportedBlog.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 28: pb.ImportBlogsRequest.blog:type_name -> pb.ImportedBlog
+	73, // 29: pb.ImportError.status:type_name -> google.rpc.Status
+	30, // 30: pb.ImportBlogsResponse.errors:type_name -> pb.ImportError
+	6,  // 31: pb.ListDeletedBlogsResponse.items:type_name -> pb.Blog
+	2,  // 32: pb.BlogRevision.action:type_name -> pb.RevisionAction
+	71, // 33: pb.BlogRevision.created_at:type_name -> google.protobuf.Timestamp
+	36, // 34: pb.ListBlogRevisionsResponse.items:type_name -> pb.BlogRevision
+	4,  // 35: pb.DiffLine.operation:type_name -> pb.DiffLine.Operation
+	42, // 36: pb.DiffBlogRevisionsResponse.title:type_name -> pb.DiffLine
+	42, // 37: pb.DiffBlogRevisionsResponse.body:type_name -> pb.DiffLine
+	71, // 38: pb.PublishBlogRequest.publish_at:type_name -> google.protobuf.Timestamp
+	46, // 39: pb.ListTagsResponse.items:type_name -> pb.Tag
+	71, // 40: pb.Author.created_at:type_name -> google.protobuf.Timestamp
+	71, // 41: pb.Author.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 42: pb.GetAuthorResponse.item:type_name -> pb.Author
+	51, // 43: pb.ListAuthorsResponse.items:type_name -> pb.Author
+	3,  // 44: pb.ReactionCount.type:type_name -> pb.ReactionType
+	3,  // 45: pb.Reaction.type:type_name -> pb.ReactionType
+	71, // 46: pb.Reaction.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 47: pb.AddReactionRequest.type:type_name -> pb.ReactionType
+	3,  // 48: pb.RemoveReactionRequest.type:type_name -> pb.ReactionType
+	3,  // 49: pb.ListReactionsRequest.type:type_name -> pb.ReactionType
+	62, // 50: pb.ListReactionsResponse.items:type_name -> pb.Reaction
+	69, // 51: pb.GetBlogViewStatsResponse.days:type_name -> pb.DailyViews
+	5,  // 52: pb.Blogger.GetBlog:input_type -> pb.GetBlogRequest
+	8,  // 53: pb.Blogger.GetBlogs:input_type -> pb.GetBlogsRequest
+	10, // 54: pb.Blogger.CreateBlog:input_type -> pb.CreateBlogRequest
+	12, // 55: pb.Blogger.UpdateBlog:input_type -> pb.UpdateBlogRequest
+	13, // 56: pb.Blogger.DeleteBlog:input_type -> pb.DeleteBlogRequest
+	14, // 57: pb.Blogger.SearchBlogs:input_type -> pb.SearchBlogsRequest
+	17, // 58: pb.Blogger.BatchGetBlogs:input_type -> pb.BatchGetBlogsRequest
+	20, // 59: pb.Blogger.BatchCreateBlogs:input_type -> pb.BatchCreateBlogsRequest
+	23, // 60: pb.Blogger.BatchDeleteBlogs:input_type -> pb.BatchDeleteBlogsRequest
+	26, // 61: pb.Blogger.WatchBlogs:input_type -> pb.WatchBlogsRequest
+	29, // 62: pb.Blogger.ImportBlogs:input_type -> pb.ImportBlogsRequest
+	32, // 63: pb.Blogger.ExportBlogs:input_type -> pb.ExportBlogsRequest
+	33, // 64: pb.Blogger.UndeleteBlog:input_type -> pb.UndeleteBlogRequest
+	34, // 65: pb.Blogger.ListDeletedBlogs:input_type -> pb.ListDeletedBlogsRequest
+	37, // 66: pb.Blogger.ListBlogRevisions:input_type -> pb.ListBlogRevisionsRequest
+	39, // 67: pb.Blogger.GetBlogRevision:input_type -> pb.GetBlogRevisionRequest
+	40, // 68: pb.Blogger.RestoreBlogRevision:input_type -> pb.RestoreBlogRevisionRequest
+	41, // 69: pb.Blogger.DiffBlogRevisions:input_type -> pb.DiffBlogRevisionsRequest
+	44, // 70: pb.Blogger.PublishBlog:input_type -> pb.PublishBlogRequest
+	45, // 71: pb.Blogger.UnpublishBlog:input_type -> pb.UnpublishBlogRequest
+	47, // 72: pb.Blogger.ListTags:input_type -> pb.ListTagsRequest
+	49, // 73: pb.Blogger.RenameTag:input_type -> pb.RenameTagRequest
+	50, // 74: pb.Blogger.MergeTags:input_type -> pb.MergeTagsRequest
+	53, // 75: pb.Blogger.CreateAuthor:input_type -> pb.CreateAuthorRequest
+	55, // 76: pb.Blogger.GetAuthor:input_type -> pb.GetAuthorRequest
+	57, // 77: pb.Blogger.ListAuthors:input_type -> pb.ListAuthorsRequest
+	59, // 78: pb.Blogger.UpdateAuthor:input_type -> pb.UpdateAuthorRequest
+	60, // 79: pb.Blogger.DeleteAuthor:input_type -> pb.DeleteAuthorRequest
+	63, // 80: pb.Blogger.AddReaction:input_type -> pb.AddReactionRequest
+	64, // 81: pb.Blogger.RemoveReaction:input_type -> pb.RemoveReactionRequest
+	65, // 82: pb.Blogger.ListReactions:input_type -> pb.ListReactionsRequest
+	67, // 83: pb.Blogger.RecordView:input_type -> pb.RecordViewRequest
+	68, // 84: pb.Blogger.GetBlogViewStats:input_type -> pb.GetBlogViewStatsRequest
+	7,  // 85: pb.Blogger.GetBlog:output_type -> pb.GetBlogResponse
+	9,  // 86: pb.Blogger.GetBlogs:output_type -> pb.GetBlogsResponse
+	11, // 87: pb.Blogger.CreateBlog:output_type -> pb.CreateBlogResponse
+	74, // 88: pb.Blogger.UpdateBlog:output_type -> google.protobuf.Empty
+	74, // 89: pb.Blogger.DeleteBlog:output_type -> google.protobuf.Empty
+	16, // 90: pb.Blogger.SearchBlogs:output_type -> pb.SearchBlogsResponse
+	19, // 91: pb.Blogger.BatchGetBlogs:output_type -> pb.BatchGetBlogsResponse
+	22, // 92: pb.Blogger.BatchCreateBlogs:output_type -> pb.BatchCreateBlogsResponse
+	25, // 93: pb.Blogger.BatchDeleteBlogs:output_type -> pb.BatchDeleteBlogsResponse
+	27, // 94: pb.Blogger.WatchBlogs:output_type -> pb.BlogChange
+	31, // 95: pb.Blogger.ImportBlogs:output_type -> pb.ImportBlogsResponse
+	6,  // 96: pb.Blogger.ExportBlogs:output_type -> pb.Blog
+	74, // 97: pb.Blogger.UndeleteBlog:output_type -> google.protobuf.Empty
+	35, // 98: pb.Blogger.ListDeletedBlogs:output_type -> pb.ListDeletedBlogsResponse
+	38, // 99: pb.Blogger.ListBlogRevisions:output_type -> pb.ListBlogRevisionsResponse
+	36, // 100: pb.Blogger.GetBlogRevision:output_type -> pb.BlogRevision
+	74, // 101: pb.Blogger.RestoreBlogRevision:output_type -> google.protobuf.Empty
+	43, // 102: pb.Blogger.DiffBlogRevisions:output_type -> pb.DiffBlogRevisionsResponse
+	74, // 103: pb.Blogger.PublishBlog:output_type -> google.protobuf.Empty
+	74, // 104: pb.Blogger.UnpublishBlog:output_type -> google.protobuf.Empty
+	48, // 105: pb.Blogger.ListTags:output_type -> pb.ListTagsResponse
+	74, // 106: pb.Blogger.RenameTag:output_type -> google.protobuf.Empty
+	74, // 107: pb.Blogger.MergeTags:output_type -> google.protobuf.Empty
+	54, // 108: pb.Blogger.CreateAuthor:output_type -> pb.CreateAuthorResponse
+	56, // 109: pb.Blogger.GetAuthor:output_type -> pb.GetAuthorResponse
+	58, // 110: pb.Blogger.ListAuthors:output_type -> pb.ListAuthorsResponse
+	74, // 111: pb.Blogger.UpdateAuthor:output_type -> google.protobuf.Empty
+	74, // 112: pb.Blogger.DeleteAuthor:output_type -> google.protobuf.Empty
+	74, // 113: pb.Blogger.AddReaction:output_type -> google.protobuf.Empty
+	74, // 114: pb.Blogger.RemoveReaction:output_type -> google.protobuf.Empty
+	66, // 115: pb.Blogger.ListReactions:output_type -> pb.ListReactionsResponse
+	74, // 116: pb.Blogger.RecordView:output_type -> google.protobuf.Empty
+	70, // 117: pb.Blogger.GetBlogViewStats:output_type -> pb.GetBlogViewStatsResponse
+	85, // [85:118] is the sub-list for method output_type
+	52, // [52:85] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_blog_proto_init() }
@@ -681,19 +5207,27 @@ func file_blog_proto_init() {
 	file_blog_proto_msgTypes[0].OneofWrappers = []any{
 		(*GetBlogRequest_Id)(nil),
 		(*GetBlogRequest_Title)(nil),
+		(*GetBlogRequest_Slug)(nil),
+	}
+	file_blog_proto_msgTypes[7].OneofWrappers = []any{}
+	file_blog_proto_msgTypes[50].OneofWrappers = []any{
+		(*GetAuthorRequest_Id)(nil),
+		(*GetAuthorRequest_Handle)(nil),
 	}
+	file_blog_proto_msgTypes[54].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_blog_proto_rawDesc), len(file_blog_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      5,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_blog_proto_goTypes,
 		DependencyIndexes: file_blog_proto_depIdxs,
+		EnumInfos:         file_blog_proto_enumTypes,
 		MessageInfos:      file_blog_proto_msgTypes,
 	}.Build()
 	File_blog_proto = out.File
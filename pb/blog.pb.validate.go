@@ -57,6 +57,8 @@ func (m *GetBlogRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for CountView
+
 	switch v := m.Value.(type) {
 	case *GetBlogRequest_Id:
 		if v == nil {
@@ -82,6 +84,18 @@ func (m *GetBlogRequest) validate(all bool) error {
 			errors = append(errors, err)
 		}
 		// no validation rules for Title
+	case *GetBlogRequest_Slug:
+		if v == nil {
+			err := GetBlogRequestValidationError{
+				field:  "Value",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Slug
 	default:
 		_ = v // ensures v is used
 	}
@@ -249,6 +263,133 @@ func (m *Blog) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Slug
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BlogValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BlogValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BlogValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return BlogMultiError(errors)
 	}
@@ -377,6 +518,8 @@ func (m *GetBlogResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Moved
+
 	if len(errors) > 0 {
 		return GetBlogResponseMultiError(errors)
 	}
@@ -483,6 +626,14 @@ func (m *GetBlogsRequest) validate(all bool) error {
 
 	// no validation rules for Sort
 
+	// no validation rules for PageToken
+
+	// no validation rules for Filter
+
+	// no validation rules for Tag
+
+	// no validation rules for AuthorId
+
 	if len(errors) > 0 {
 		return GetBlogsRequestMultiError(errors)
 	}
@@ -623,10 +774,14 @@ func (m *GetBlogsResponse) validate(all bool) error {
 
 	// no validation rules for Sort
 
+	// no validation rules for Filter
+
 	// no validation rules for TotalItems
 
 	// no validation rules for TotalPages
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return GetBlogsResponseMultiError(errors)
 	}
@@ -731,6 +886,10 @@ func (m *CreateBlogRequest) validate(all bool) error {
 
 	// no validation rules for Body
 
+	// no validation rules for Status
+
+	// no validation rules for AuthorId
+
 	if len(errors) > 0 {
 		return CreateBlogRequestMultiError(errors)
 	}
@@ -939,9 +1098,48 @@ func (m *UpdateBlogRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Title
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBlogRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBlogRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBlogRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Body
+	// no validation rules for Etag
+
+	if m.Title != nil {
+		// no validation rules for Title
+	}
+
+	if m.Body != nil {
+		// no validation rules for Body
+	}
+
+	if m.AuthorId != nil {
+		// no validation rules for AuthorId
+	}
 
 	if len(errors) > 0 {
 		return UpdateBlogRequestMultiError(errors)
@@ -1047,6 +1245,8 @@ func (m *DeleteBlogRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Etag
+
 	if len(errors) > 0 {
 		return DeleteBlogRequestMultiError(errors)
 	}
@@ -1126,3 +1326,7029 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteBlogRequestValidationError{}
+
+// Validate checks the field values on SearchBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchBlogsRequestMultiError, or nil if none found.
+func (m *SearchBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return SearchBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchBlogsRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchBlogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchBlogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchBlogsRequestMultiError) AllErrors() []error { return m }
+
+// SearchBlogsRequestValidationError is the validation error returned by
+// SearchBlogsRequest.Validate if the designated constraints aren't met.
+type SearchBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchBlogsRequestValidationError) ErrorName() string {
+	return "SearchBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBlogsRequestValidationError{}
+
+// Validate checks the field values on SearchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchResultMultiError, or
+// nil if none found.
+func (m *SearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchResultValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchResultValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	// no validation rules for TitleHighlight
+
+	// no validation rules for BodyHighlight
+
+	if len(errors) > 0 {
+		return SearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchResultMultiError is an error wrapping multiple validation errors
+// returned by SearchResult.ValidateAll() if the designated constraints aren't met.
+type SearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchResultMultiError) AllErrors() []error { return m }
+
+// SearchResultValidationError is the validation error returned by
+// SearchResult.Validate if the designated constraints aren't met.
+type SearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchResultValidationError) ErrorName() string { return "SearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchResultValidationError{}
+
+// Validate checks the field values on SearchBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBlogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchBlogsResponseMultiError, or nil if none found.
+func (m *SearchBlogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchBlogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchBlogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchBlogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchBlogsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchBlogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchBlogsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchBlogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchBlogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchBlogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchBlogsResponseMultiError) AllErrors() []error { return m }
+
+// SearchBlogsResponseValidationError is the validation error returned by
+// SearchBlogsResponse.Validate if the designated constraints aren't met.
+type SearchBlogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchBlogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchBlogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchBlogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchBlogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchBlogsResponseValidationError) ErrorName() string {
+	return "SearchBlogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBlogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBlogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBlogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBlogsResponseValidationError{}
+
+// Validate checks the field values on BatchGetBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetBlogsRequestMultiError, or nil if none found.
+func (m *BatchGetBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BatchGetBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetBlogsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetBlogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetBlogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetBlogsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetBlogsRequestValidationError is the validation error returned by
+// BatchGetBlogsRequest.Validate if the designated constraints aren't met.
+type BatchGetBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetBlogsRequestValidationError) ErrorName() string {
+	return "BatchGetBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetBlogsRequestValidationError{}
+
+// Validate checks the field values on BatchGetBlogResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetBlogResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetBlogResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetBlogResultMultiError, or nil if none found.
+func (m *BatchGetBlogResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetBlogResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchGetBlogResultValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchGetBlogResultValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchGetBlogResultValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchGetBlogResultValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchGetBlogResultValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchGetBlogResultValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchGetBlogResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetBlogResultMultiError is an error wrapping multiple validation errors
+// returned by BatchGetBlogResult.ValidateAll() if the designated constraints
+// aren't met.
+type BatchGetBlogResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetBlogResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetBlogResultMultiError) AllErrors() []error { return m }
+
+// BatchGetBlogResultValidationError is the validation error returned by
+// BatchGetBlogResult.Validate if the designated constraints aren't met.
+type BatchGetBlogResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetBlogResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetBlogResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetBlogResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetBlogResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetBlogResultValidationError) ErrorName() string {
+	return "BatchGetBlogResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetBlogResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetBlogResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetBlogResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetBlogResultValidationError{}
+
+// Validate checks the field values on BatchGetBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetBlogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetBlogsResponseMultiError, or nil if none found.
+func (m *BatchGetBlogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetBlogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetBlogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetBlogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetBlogsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetBlogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetBlogsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetBlogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetBlogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetBlogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetBlogsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetBlogsResponseValidationError is the validation error returned by
+// BatchGetBlogsResponse.Validate if the designated constraints aren't met.
+type BatchGetBlogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetBlogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetBlogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetBlogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetBlogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetBlogsResponseValidationError) ErrorName() string {
+	return "BatchGetBlogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetBlogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetBlogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetBlogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetBlogsResponseValidationError{}
+
+// Validate checks the field values on BatchCreateBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreateBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateBlogsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreateBlogsRequestMultiError, or nil if none found.
+func (m *BatchCreateBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateBlogsRequestValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateBlogsRequestValidationError{
+						field:  fmt.Sprintf("Requests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateBlogsRequestValidationError{
+					field:  fmt.Sprintf("Requests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AllOrNothing
+
+	if len(errors) > 0 {
+		return BatchCreateBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreateBlogsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchCreateBlogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCreateBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreateBlogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreateBlogsRequestMultiError) AllErrors() []error { return m }
+
+// BatchCreateBlogsRequestValidationError is the validation error returned by
+// BatchCreateBlogsRequest.Validate if the designated constraints aren't met.
+type BatchCreateBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreateBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreateBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreateBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreateBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreateBlogsRequestValidationError) ErrorName() string {
+	return "BatchCreateBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreateBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreateBlogsRequestValidationError{}
+
+// Validate checks the field values on BatchCreateBlogResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreateBlogResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateBlogResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreateBlogResultMultiError, or nil if none found.
+func (m *BatchCreateBlogResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateBlogResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchCreateBlogResultValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchCreateBlogResultValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchCreateBlogResultValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchCreateBlogResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreateBlogResultMultiError is an error wrapping multiple validation
+// errors returned by BatchCreateBlogResult.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCreateBlogResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreateBlogResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreateBlogResultMultiError) AllErrors() []error { return m }
+
+// BatchCreateBlogResultValidationError is the validation error returned by
+// BatchCreateBlogResult.Validate if the designated constraints aren't met.
+type BatchCreateBlogResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreateBlogResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreateBlogResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreateBlogResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreateBlogResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreateBlogResultValidationError) ErrorName() string {
+	return "BatchCreateBlogResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateBlogResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateBlogResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreateBlogResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreateBlogResultValidationError{}
+
+// Validate checks the field values on BatchCreateBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCreateBlogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCreateBlogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCreateBlogsResponseMultiError, or nil if none found.
+func (m *BatchCreateBlogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCreateBlogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCreateBlogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCreateBlogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCreateBlogsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCreateBlogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCreateBlogsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchCreateBlogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCreateBlogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCreateBlogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCreateBlogsResponseMultiError) AllErrors() []error { return m }
+
+// BatchCreateBlogsResponseValidationError is the validation error returned by
+// BatchCreateBlogsResponse.Validate if the designated constraints aren't met.
+type BatchCreateBlogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCreateBlogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCreateBlogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCreateBlogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCreateBlogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCreateBlogsResponseValidationError) ErrorName() string {
+	return "BatchCreateBlogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCreateBlogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCreateBlogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCreateBlogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCreateBlogsResponseValidationError{}
+
+// Validate checks the field values on BatchDeleteBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteBlogsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteBlogsRequestMultiError, or nil if none found.
+func (m *BatchDeleteBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AllOrNothing
+
+	if len(errors) > 0 {
+		return BatchDeleteBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteBlogsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchDeleteBlogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchDeleteBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteBlogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteBlogsRequestMultiError) AllErrors() []error { return m }
+
+// BatchDeleteBlogsRequestValidationError is the validation error returned by
+// BatchDeleteBlogsRequest.Validate if the designated constraints aren't met.
+type BatchDeleteBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteBlogsRequestValidationError) ErrorName() string {
+	return "BatchDeleteBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteBlogsRequestValidationError{}
+
+// Validate checks the field values on BatchDeleteBlogResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteBlogResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteBlogResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteBlogResultMultiError, or nil if none found.
+func (m *BatchDeleteBlogResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteBlogResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchDeleteBlogResultValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchDeleteBlogResultValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchDeleteBlogResultValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BatchDeleteBlogResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteBlogResultMultiError is an error wrapping multiple validation
+// errors returned by BatchDeleteBlogResult.ValidateAll() if the designated
+// constraints aren't met.
+type BatchDeleteBlogResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteBlogResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteBlogResultMultiError) AllErrors() []error { return m }
+
+// BatchDeleteBlogResultValidationError is the validation error returned by
+// BatchDeleteBlogResult.Validate if the designated constraints aren't met.
+type BatchDeleteBlogResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteBlogResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteBlogResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteBlogResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteBlogResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteBlogResultValidationError) ErrorName() string {
+	return "BatchDeleteBlogResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteBlogResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteBlogResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteBlogResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteBlogResultValidationError{}
+
+// Validate checks the field values on BatchDeleteBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDeleteBlogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDeleteBlogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDeleteBlogsResponseMultiError, or nil if none found.
+func (m *BatchDeleteBlogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDeleteBlogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDeleteBlogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDeleteBlogsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDeleteBlogsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchDeleteBlogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDeleteBlogsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchDeleteBlogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchDeleteBlogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDeleteBlogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDeleteBlogsResponseMultiError) AllErrors() []error { return m }
+
+// BatchDeleteBlogsResponseValidationError is the validation error returned by
+// BatchDeleteBlogsResponse.Validate if the designated constraints aren't met.
+type BatchDeleteBlogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteBlogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteBlogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteBlogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteBlogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteBlogsResponseValidationError) ErrorName() string {
+	return "BatchDeleteBlogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteBlogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteBlogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteBlogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteBlogsResponseValidationError{}
+
+// Validate checks the field values on WatchBlogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchBlogsRequestMultiError, or nil if none found.
+func (m *WatchBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AfterSequence
+
+	// no validation rules for Filter
+
+	if len(errors) > 0 {
+		return WatchBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchBlogsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchBlogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchBlogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchBlogsRequestMultiError) AllErrors() []error { return m }
+
+// WatchBlogsRequestValidationError is the validation error returned by
+// WatchBlogsRequest.Validate if the designated constraints aren't met.
+type WatchBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchBlogsRequestValidationError) ErrorName() string {
+	return "WatchBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchBlogsRequestValidationError{}
+
+// Validate checks the field values on BlogChange with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlogChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlogChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlogChangeMultiError, or
+// nil if none found.
+func (m *BlogChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlogChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogChangeValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogChangeValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogChangeValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetChangedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogChangeValidationError{
+					field:  "ChangedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChangedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogChangeValidationError{
+				field:  "ChangedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlogChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlogChangeMultiError is an error wrapping multiple validation errors
+// returned by BlogChange.ValidateAll() if the designated constraints aren't met.
+type BlogChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlogChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlogChangeMultiError) AllErrors() []error { return m }
+
+// BlogChangeValidationError is the validation error returned by
+// BlogChange.Validate if the designated constraints aren't met.
+type BlogChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlogChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlogChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlogChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlogChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlogChangeValidationError) ErrorName() string { return "BlogChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlogChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlogChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlogChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlogChangeValidationError{}
+
+// Validate checks the field values on ImportedBlog with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportedBlog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportedBlog with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportedBlogMultiError, or
+// nil if none found.
+func (m *ImportedBlog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportedBlog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceId
+
+	// no validation rules for Title
+
+	// no validation rules for Body
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportedBlogValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportedBlogValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportedBlogValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportedBlogValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportedBlogValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportedBlogValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportedBlogMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportedBlogMultiError is an error wrapping multiple validation errors
+// returned by ImportedBlog.ValidateAll() if the designated constraints aren't met.
+type ImportedBlogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportedBlogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportedBlogMultiError) AllErrors() []error { return m }
+
+// ImportedBlogValidationError is the validation error returned by
+// ImportedBlog.Validate if the designated constraints aren't met.
+type ImportedBlogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportedBlogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportedBlogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportedBlogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportedBlogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportedBlogValidationError) ErrorName() string { return "ImportedBlogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportedBlogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportedBlog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportedBlogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportedBlogValidationError{}
+
+// Validate checks the field values on ImportBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBlogsRequestMultiError, or nil if none found.
+func (m *ImportBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportBlogsRequestValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportBlogsRequestValidationError{
+					field:  "Blog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportBlogsRequestValidationError{
+				field:  "Blog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBlogsRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportBlogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportBlogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportBlogsRequestMultiError) AllErrors() []error { return m }
+
+// ImportBlogsRequestValidationError is the validation error returned by
+// ImportBlogsRequest.Validate if the designated constraints aren't met.
+type ImportBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportBlogsRequestValidationError) ErrorName() string {
+	return "ImportBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportBlogsRequestValidationError{}
+
+// Validate checks the field values on ImportError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportErrorMultiError, or
+// nil if none found.
+func (m *ImportError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for SourceId
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportErrorValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportErrorValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportErrorValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportErrorMultiError is an error wrapping multiple validation errors
+// returned by ImportError.ValidateAll() if the designated constraints aren't met.
+type ImportErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportErrorMultiError) AllErrors() []error { return m }
+
+// ImportErrorValidationError is the validation error returned by
+// ImportError.Validate if the designated constraints aren't met.
+type ImportErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportErrorValidationError) ErrorName() string { return "ImportErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportErrorValidationError{}
+
+// Validate checks the field values on ImportBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportBlogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBlogsResponseMultiError, or nil if none found.
+func (m *ImportBlogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportBlogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Inserted
+
+	// no validation rules for Skipped
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportBlogsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportBlogsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportBlogsResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportBlogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBlogsResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportBlogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportBlogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportBlogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportBlogsResponseMultiError) AllErrors() []error { return m }
+
+// ImportBlogsResponseValidationError is the validation error returned by
+// ImportBlogsResponse.Validate if the designated constraints aren't met.
+type ImportBlogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportBlogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportBlogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportBlogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportBlogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportBlogsResponseValidationError) ErrorName() string {
+	return "ImportBlogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportBlogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportBlogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportBlogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportBlogsResponseValidationError{}
+
+// Validate checks the field values on ExportBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportBlogsRequestMultiError, or nil if none found.
+func (m *ExportBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Filter
+
+	if len(errors) > 0 {
+		return ExportBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportBlogsRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportBlogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportBlogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportBlogsRequestMultiError) AllErrors() []error { return m }
+
+// ExportBlogsRequestValidationError is the validation error returned by
+// ExportBlogsRequest.Validate if the designated constraints aren't met.
+type ExportBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportBlogsRequestValidationError) ErrorName() string {
+	return "ExportBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportBlogsRequestValidationError{}
+
+// Validate checks the field values on UndeleteBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UndeleteBlogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndeleteBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UndeleteBlogRequestMultiError, or nil if none found.
+func (m *UndeleteBlogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndeleteBlogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return UndeleteBlogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndeleteBlogRequestMultiError is an error wrapping multiple validation
+// errors returned by UndeleteBlogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UndeleteBlogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndeleteBlogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndeleteBlogRequestMultiError) AllErrors() []error { return m }
+
+// UndeleteBlogRequestValidationError is the validation error returned by
+// UndeleteBlogRequest.Validate if the designated constraints aren't met.
+type UndeleteBlogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndeleteBlogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndeleteBlogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndeleteBlogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndeleteBlogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndeleteBlogRequestValidationError) ErrorName() string {
+	return "UndeleteBlogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UndeleteBlogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndeleteBlogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndeleteBlogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndeleteBlogRequestValidationError{}
+
+// Validate checks the field values on ListDeletedBlogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedBlogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedBlogsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedBlogsRequestMultiError, or nil if none found.
+func (m *ListDeletedBlogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedBlogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return ListDeletedBlogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedBlogsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedBlogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedBlogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedBlogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedBlogsRequestMultiError) AllErrors() []error { return m }
+
+// ListDeletedBlogsRequestValidationError is the validation error returned by
+// ListDeletedBlogsRequest.Validate if the designated constraints aren't met.
+type ListDeletedBlogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedBlogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedBlogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedBlogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedBlogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedBlogsRequestValidationError) ErrorName() string {
+	return "ListDeletedBlogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedBlogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedBlogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedBlogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedBlogsRequestValidationError{}
+
+// Validate checks the field values on ListDeletedBlogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeletedBlogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeletedBlogsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeletedBlogsResponseMultiError, or nil if none found.
+func (m *ListDeletedBlogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeletedBlogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeletedBlogsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeletedBlogsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeletedBlogsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return ListDeletedBlogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeletedBlogsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeletedBlogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeletedBlogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeletedBlogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeletedBlogsResponseMultiError) AllErrors() []error { return m }
+
+// ListDeletedBlogsResponseValidationError is the validation error returned by
+// ListDeletedBlogsResponse.Validate if the designated constraints aren't met.
+type ListDeletedBlogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeletedBlogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeletedBlogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeletedBlogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeletedBlogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeletedBlogsResponseValidationError) ErrorName() string {
+	return "ListDeletedBlogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeletedBlogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeletedBlogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeletedBlogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeletedBlogsResponseValidationError{}
+
+// Validate checks the field values on BlogRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlogRevision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlogRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlogRevisionMultiError, or
+// nil if none found.
+func (m *BlogRevision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlogRevision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for Revision
+
+	// no validation rules for Action
+
+	// no validation rules for Title
+
+	// no validation rules for Body
+
+	// no validation rules for Editor
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BlogRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BlogRevisionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BlogRevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BlogRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlogRevisionMultiError is an error wrapping multiple validation errors
+// returned by BlogRevision.ValidateAll() if the designated constraints aren't met.
+type BlogRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlogRevisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlogRevisionMultiError) AllErrors() []error { return m }
+
+// BlogRevisionValidationError is the validation error returned by
+// BlogRevision.Validate if the designated constraints aren't met.
+type BlogRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlogRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlogRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlogRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlogRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlogRevisionValidationError) ErrorName() string { return "BlogRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlogRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlogRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlogRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlogRevisionValidationError{}
+
+// Validate checks the field values on ListBlogRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBlogRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlogRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBlogRevisionsRequestMultiError, or nil if none found.
+func (m *ListBlogRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlogRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return ListBlogRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlogRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBlogRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBlogRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlogRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlogRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListBlogRevisionsRequestValidationError is the validation error returned by
+// ListBlogRevisionsRequest.Validate if the designated constraints aren't met.
+type ListBlogRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlogRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlogRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlogRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlogRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlogRevisionsRequestValidationError) ErrorName() string {
+	return "ListBlogRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBlogRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlogRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlogRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlogRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListBlogRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBlogRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlogRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBlogRevisionsResponseMultiError, or nil if none found.
+func (m *ListBlogRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlogRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBlogRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBlogRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBlogRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return ListBlogRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlogRevisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBlogRevisionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListBlogRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlogRevisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlogRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListBlogRevisionsResponseValidationError is the validation error returned by
+// ListBlogRevisionsResponse.Validate if the designated constraints aren't met.
+type ListBlogRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlogRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlogRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlogRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlogRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlogRevisionsResponseValidationError) ErrorName() string {
+	return "ListBlogRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBlogRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlogRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlogRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlogRevisionsResponseValidationError{}
+
+// Validate checks the field values on GetBlogRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBlogRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBlogRevisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBlogRevisionRequestMultiError, or nil if none found.
+func (m *GetBlogRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBlogRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return GetBlogRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBlogRevisionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBlogRevisionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBlogRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBlogRevisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBlogRevisionRequestMultiError) AllErrors() []error { return m }
+
+// GetBlogRevisionRequestValidationError is the validation error returned by
+// GetBlogRevisionRequest.Validate if the designated constraints aren't met.
+type GetBlogRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBlogRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBlogRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBlogRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBlogRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBlogRevisionRequestValidationError) ErrorName() string {
+	return "GetBlogRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBlogRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBlogRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBlogRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBlogRevisionRequestValidationError{}
+
+// Validate checks the field values on RestoreBlogRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreBlogRevisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreBlogRevisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreBlogRevisionRequestMultiError, or nil if none found.
+func (m *RestoreBlogRevisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreBlogRevisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for Revision
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return RestoreBlogRevisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreBlogRevisionRequestMultiError is an error wrapping multiple
+// validation errors returned by RestoreBlogRevisionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RestoreBlogRevisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreBlogRevisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreBlogRevisionRequestMultiError) AllErrors() []error { return m }
+
+// RestoreBlogRevisionRequestValidationError is the validation error returned
+// by RestoreBlogRevisionRequest.Validate if the designated constraints aren't met.
+type RestoreBlogRevisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreBlogRevisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreBlogRevisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreBlogRevisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreBlogRevisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreBlogRevisionRequestValidationError) ErrorName() string {
+	return "RestoreBlogRevisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreBlogRevisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreBlogRevisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreBlogRevisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreBlogRevisionRequestValidationError{}
+
+// Validate checks the field values on DiffBlogRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffBlogRevisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffBlogRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffBlogRevisionsRequestMultiError, or nil if none found.
+func (m *DiffBlogRevisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffBlogRevisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for FromRevision
+
+	// no validation rules for ToRevision
+
+	if len(errors) > 0 {
+		return DiffBlogRevisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffBlogRevisionsRequestMultiError is an error wrapping multiple validation
+// errors returned by DiffBlogRevisionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiffBlogRevisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffBlogRevisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffBlogRevisionsRequestMultiError) AllErrors() []error { return m }
+
+// DiffBlogRevisionsRequestValidationError is the validation error returned by
+// DiffBlogRevisionsRequest.Validate if the designated constraints aren't met.
+type DiffBlogRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffBlogRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffBlogRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffBlogRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffBlogRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffBlogRevisionsRequestValidationError) ErrorName() string {
+	return "DiffBlogRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffBlogRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffBlogRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffBlogRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffBlogRevisionsRequestValidationError{}
+
+// Validate checks the field values on DiffLine with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DiffLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiffLineMultiError, or nil
+// if none found.
+func (m *DiffLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return DiffLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffLineMultiError is an error wrapping multiple validation errors returned
+// by DiffLine.ValidateAll() if the designated constraints aren't met.
+type DiffLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffLineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffLineMultiError) AllErrors() []error { return m }
+
+// DiffLineValidationError is the validation error returned by
+// DiffLine.Validate if the designated constraints aren't met.
+type DiffLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffLineValidationError) ErrorName() string { return "DiffLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiffLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffLineValidationError{}
+
+// Validate checks the field values on DiffBlogRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffBlogRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffBlogRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffBlogRevisionsResponseMultiError, or nil if none found.
+func (m *DiffBlogRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffBlogRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTitle() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffBlogRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Title[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffBlogRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Title[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffBlogRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Title[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBody() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffBlogRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Body[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffBlogRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Body[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffBlogRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Body[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiffBlogRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffBlogRevisionsResponseMultiError is an error wrapping multiple validation
+// errors returned by DiffBlogRevisionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DiffBlogRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffBlogRevisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffBlogRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// DiffBlogRevisionsResponseValidationError is the validation error returned by
+// DiffBlogRevisionsResponse.Validate if the designated constraints aren't met.
+type DiffBlogRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffBlogRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffBlogRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffBlogRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffBlogRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffBlogRevisionsResponseValidationError) ErrorName() string {
+	return "DiffBlogRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffBlogRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffBlogRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffBlogRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffBlogRevisionsResponseValidationError{}
+
+// Validate checks the field values on PublishBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PublishBlogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublishBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublishBlogRequestMultiError, or nil if none found.
+func (m *PublishBlogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublishBlogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetPublishAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PublishBlogRequestValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PublishBlogRequestValidationError{
+					field:  "PublishAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PublishBlogRequestValidationError{
+				field:  "PublishAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return PublishBlogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublishBlogRequestMultiError is an error wrapping multiple validation errors
+// returned by PublishBlogRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PublishBlogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublishBlogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublishBlogRequestMultiError) AllErrors() []error { return m }
+
+// PublishBlogRequestValidationError is the validation error returned by
+// PublishBlogRequest.Validate if the designated constraints aren't met.
+type PublishBlogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishBlogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishBlogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishBlogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishBlogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishBlogRequestValidationError) ErrorName() string {
+	return "PublishBlogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishBlogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishBlogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishBlogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishBlogRequestValidationError{}
+
+// Validate checks the field values on UnpublishBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpublishBlogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpublishBlogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpublishBlogRequestMultiError, or nil if none found.
+func (m *UnpublishBlogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpublishBlogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Archive
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return UnpublishBlogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpublishBlogRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpublishBlogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnpublishBlogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpublishBlogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpublishBlogRequestMultiError) AllErrors() []error { return m }
+
+// UnpublishBlogRequestValidationError is the validation error returned by
+// UnpublishBlogRequest.Validate if the designated constraints aren't met.
+type UnpublishBlogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpublishBlogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpublishBlogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpublishBlogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpublishBlogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpublishBlogRequestValidationError) ErrorName() string {
+	return "UnpublishBlogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpublishBlogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpublishBlogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpublishBlogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpublishBlogRequestValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for BlogCount
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on ListTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsRequestMultiError, or nil if none found.
+func (m *ListTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return ListTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsRequestMultiError) AllErrors() []error { return m }
+
+// ListTagsRequestValidationError is the validation error returned by
+// ListTagsRequest.Validate if the designated constraints aren't met.
+type ListTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRequestValidationError) ErrorName() string { return "ListTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRequestValidationError{}
+
+// Validate checks the field values on ListTagsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsResponseMultiError, or nil if none found.
+func (m *ListTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return ListTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTagsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsResponseMultiError) AllErrors() []error { return m }
+
+// ListTagsResponseValidationError is the validation error returned by
+// ListTagsResponse.Validate if the designated constraints aren't met.
+type ListTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsResponseValidationError) ErrorName() string { return "ListTagsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsResponseValidationError{}
+
+// Validate checks the field values on RenameTagRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameTagRequestMultiError, or nil if none found.
+func (m *RenameTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for NewName
+
+	if len(errors) > 0 {
+		return RenameTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameTagRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameTagRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameTagRequestMultiError) AllErrors() []error { return m }
+
+// RenameTagRequestValidationError is the validation error returned by
+// RenameTagRequest.Validate if the designated constraints aren't met.
+type RenameTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameTagRequestValidationError) ErrorName() string { return "RenameTagRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RenameTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameTagRequestValidationError{}
+
+// Validate checks the field values on MergeTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeTagsRequestMultiError, or nil if none found.
+func (m *MergeTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Target
+
+	if len(errors) > 0 {
+		return MergeTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeTagsRequestMultiError) AllErrors() []error { return m }
+
+// MergeTagsRequestValidationError is the validation error returned by
+// MergeTagsRequest.Validate if the designated constraints aren't met.
+type MergeTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeTagsRequestValidationError) ErrorName() string { return "MergeTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MergeTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeTagsRequestValidationError{}
+
+// Validate checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Author) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuthorMultiError, or nil if none found.
+func (m *Author) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Author) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Handle
+
+	// no validation rules for Name
+
+	// no validation rules for Bio
+
+	// no validation rules for AvatarUrl
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthorValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthorValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorMultiError is an error wrapping multiple validation errors returned by
+// Author.ValidateAll() if the designated constraints aren't met.
+type AuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorMultiError) AllErrors() []error { return m }
+
+// AuthorValidationError is the validation error returned by Author.Validate if
+// the designated constraints aren't met.
+type AuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorValidationError) ErrorName() string { return "AuthorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorValidationError{}
+
+// Validate checks the field values on AuthorSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthorSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthorSummaryMultiError, or
+// nil if none found.
+func (m *AuthorSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Handle
+
+	// no validation rules for Name
+
+	// no validation rules for AvatarUrl
+
+	if len(errors) > 0 {
+		return AuthorSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorSummaryMultiError is an error wrapping multiple validation errors
+// returned by AuthorSummary.ValidateAll() if the designated constraints
+// aren't met.
+type AuthorSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorSummaryMultiError) AllErrors() []error { return m }
+
+// AuthorSummaryValidationError is the validation error returned by
+// AuthorSummary.Validate if the designated constraints aren't met.
+type AuthorSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorSummaryValidationError) ErrorName() string { return "AuthorSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorSummaryValidationError{}
+
+// Validate checks the field values on CreateAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAuthorRequestMultiError, or nil if none found.
+func (m *CreateAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Handle
+
+	// no validation rules for Name
+
+	// no validation rules for Bio
+
+	// no validation rules for AvatarUrl
+
+	if len(errors) > 0 {
+		return CreateAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAuthorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAuthorRequestMultiError) AllErrors() []error { return m }
+
+// CreateAuthorRequestValidationError is the validation error returned by
+// CreateAuthorRequest.Validate if the designated constraints aren't met.
+type CreateAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAuthorRequestValidationError) ErrorName() string {
+	return "CreateAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAuthorRequestValidationError{}
+
+// Validate checks the field values on CreateAuthorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAuthorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAuthorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAuthorResponseMultiError, or nil if none found.
+func (m *CreateAuthorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAuthorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CreateAuthorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAuthorResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAuthorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAuthorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAuthorResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAuthorResponseMultiError) AllErrors() []error { return m }
+
+// CreateAuthorResponseValidationError is the validation error returned by
+// CreateAuthorResponse.Validate if the designated constraints aren't met.
+type CreateAuthorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAuthorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAuthorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAuthorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAuthorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAuthorResponseValidationError) ErrorName() string {
+	return "CreateAuthorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAuthorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAuthorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAuthorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAuthorResponseValidationError{}
+
+// Validate checks the field values on GetAuthorRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorRequestMultiError, or nil if none found.
+func (m *GetAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Value.(type) {
+	case *GetAuthorRequest_Id:
+		if v == nil {
+			err := GetAuthorRequestValidationError{
+				field:  "Value",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Id
+	case *GetAuthorRequest_Handle:
+		if v == nil {
+			err := GetAuthorRequestValidationError{
+				field:  "Value",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Handle
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAuthorRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorRequestMultiError) AllErrors() []error { return m }
+
+// GetAuthorRequestValidationError is the validation error returned by
+// GetAuthorRequest.Validate if the designated constraints aren't met.
+type GetAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorRequestValidationError) ErrorName() string { return "GetAuthorRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorRequestValidationError{}
+
+// Validate checks the field values on GetAuthorResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAuthorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuthorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuthorResponseMultiError, or nil if none found.
+func (m *GetAuthorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuthorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAuthorResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAuthorResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAuthorResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAuthorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuthorResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAuthorResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuthorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuthorResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuthorResponseMultiError) AllErrors() []error { return m }
+
+// GetAuthorResponseValidationError is the validation error returned by
+// GetAuthorResponse.Validate if the designated constraints aren't met.
+type GetAuthorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuthorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuthorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuthorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuthorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuthorResponseValidationError) ErrorName() string {
+	return "GetAuthorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuthorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuthorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuthorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuthorResponseValidationError{}
+
+// Validate checks the field values on ListAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuthorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuthorsRequestMultiError, or nil if none found.
+func (m *ListAuthorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return ListAuthorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuthorsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAuthorsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAuthorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthorsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthorsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuthorsRequestValidationError is the validation error returned by
+// ListAuthorsRequest.Validate if the designated constraints aren't met.
+type ListAuthorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthorsRequestValidationError) ErrorName() string {
+	return "ListAuthorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuthorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthorsRequestValidationError{}
+
+// Validate checks the field values on ListAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuthorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuthorsResponseMultiError, or nil if none found.
+func (m *ListAuthorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuthorsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuthorsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuthorsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return ListAuthorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuthorsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuthorsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuthorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthorsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthorsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuthorsResponseValidationError is the validation error returned by
+// ListAuthorsResponse.Validate if the designated constraints aren't met.
+type ListAuthorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthorsResponseValidationError) ErrorName() string {
+	return "ListAuthorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuthorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthorsResponseValidationError{}
+
+// Validate checks the field values on UpdateAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAuthorRequestMultiError, or nil if none found.
+func (m *UpdateAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Handle != nil {
+		// no validation rules for Handle
+	}
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Bio != nil {
+		// no validation rules for Bio
+	}
+
+	if m.AvatarUrl != nil {
+		// no validation rules for AvatarUrl
+	}
+
+	if len(errors) > 0 {
+		return UpdateAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAuthorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAuthorRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAuthorRequestValidationError is the validation error returned by
+// UpdateAuthorRequest.Validate if the designated constraints aren't met.
+type UpdateAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAuthorRequestValidationError) ErrorName() string {
+	return "UpdateAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAuthorRequestValidationError{}
+
+// Validate checks the field values on DeleteAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAuthorRequestMultiError, or nil if none found.
+func (m *DeleteAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAuthorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAuthorRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAuthorRequestValidationError is the validation error returned by
+// DeleteAuthorRequest.Validate if the designated constraints aren't met.
+type DeleteAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAuthorRequestValidationError) ErrorName() string {
+	return "DeleteAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAuthorRequestValidationError{}
+
+// Validate checks the field values on ReactionCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReactionCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactionCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionCountMultiError, or
+// nil if none found.
+func (m *ReactionCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactionCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ReactionCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionCountMultiError is an error wrapping multiple validation errors
+// returned by ReactionCount.ValidateAll() if the designated constraints
+// aren't met.
+type ReactionCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionCountMultiError) AllErrors() []error { return m }
+
+// ReactionCountValidationError is the validation error returned by
+// ReactionCount.Validate if the designated constraints aren't met.
+type ReactionCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionCountValidationError) ErrorName() string { return "ReactionCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactionCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionCountValidationError{}
+
+// Validate checks the field values on Reaction with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Reaction with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionMultiError, or nil
+// if none found.
+func (m *Reaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Reaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for UserId
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReactionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReactionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReactionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionMultiError is an error wrapping multiple validation errors returned
+// by Reaction.ValidateAll() if the designated constraints aren't met.
+type ReactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionMultiError) AllErrors() []error { return m }
+
+// ReactionValidationError is the validation error returned by
+// Reaction.Validate if the designated constraints aren't met.
+type ReactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionValidationError) ErrorName() string { return "ReactionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionValidationError{}
+
+// Validate checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddReactionRequestMultiError, or nil if none found.
+func (m *AddReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for UserId
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return AddReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddReactionRequestMultiError is an error wrapping multiple validation errors
+// returned by AddReactionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddReactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddReactionRequestMultiError) AllErrors() []error { return m }
+
+// AddReactionRequestValidationError is the validation error returned by
+// AddReactionRequest.Validate if the designated constraints aren't met.
+type AddReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddReactionRequestValidationError) ErrorName() string {
+	return "AddReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddReactionRequestValidationError{}
+
+// Validate checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveReactionRequestMultiError, or nil if none found.
+func (m *RemoveReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for UserId
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return RemoveReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveReactionRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveReactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveReactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveReactionRequestMultiError) AllErrors() []error { return m }
+
+// RemoveReactionRequestValidationError is the validation error returned by
+// RemoveReactionRequest.Validate if the designated constraints aren't met.
+type RemoveReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveReactionRequestValidationError) ErrorName() string {
+	return "RemoveReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveReactionRequestValidationError{}
+
+// Validate checks the field values on ListReactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReactionsRequestMultiError, or nil if none found.
+func (m *ListReactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for Type
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return ListReactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReactionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReactionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListReactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReactionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReactionsRequestMultiError) AllErrors() []error { return m }
+
+// ListReactionsRequestValidationError is the validation error returned by
+// ListReactionsRequest.Validate if the designated constraints aren't met.
+type ListReactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReactionsRequestValidationError) ErrorName() string {
+	return "ListReactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReactionsRequestValidationError{}
+
+// Validate checks the field values on ListReactionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReactionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReactionsResponseMultiError, or nil if none found.
+func (m *ListReactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReactionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReactionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReactionsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Page
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return ListReactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReactionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReactionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReactionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReactionsResponseMultiError) AllErrors() []error { return m }
+
+// ListReactionsResponseValidationError is the validation error returned by
+// ListReactionsResponse.Validate if the designated constraints aren't met.
+type ListReactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReactionsResponseValidationError) ErrorName() string {
+	return "ListReactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReactionsResponseValidationError{}
+
+// Validate checks the field values on RecordViewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RecordViewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordViewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordViewRequestMultiError, or nil if none found.
+func (m *RecordViewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordViewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	if len(errors) > 0 {
+		return RecordViewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordViewRequestMultiError is an error wrapping multiple validation errors
+// returned by RecordViewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RecordViewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordViewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordViewRequestMultiError) AllErrors() []error { return m }
+
+// RecordViewRequestValidationError is the validation error returned by
+// RecordViewRequest.Validate if the designated constraints aren't met.
+type RecordViewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordViewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordViewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordViewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordViewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordViewRequestValidationError) ErrorName() string {
+	return "RecordViewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordViewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordViewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordViewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordViewRequestValidationError{}
+
+// Validate checks the field values on GetBlogViewStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBlogViewStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBlogViewStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBlogViewStatsRequestMultiError, or nil if none found.
+func (m *GetBlogViewStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBlogViewStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BlogId
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if len(errors) > 0 {
+		return GetBlogViewStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBlogViewStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBlogViewStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBlogViewStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBlogViewStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBlogViewStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetBlogViewStatsRequestValidationError is the validation error returned by
+// GetBlogViewStatsRequest.Validate if the designated constraints aren't met.
+type GetBlogViewStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBlogViewStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBlogViewStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBlogViewStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBlogViewStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBlogViewStatsRequestValidationError) ErrorName() string {
+	return "GetBlogViewStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBlogViewStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBlogViewStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBlogViewStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBlogViewStatsRequestValidationError{}
+
+// Validate checks the field values on DailyViews with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DailyViews) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyViews with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DailyViewsMultiError, or
+// nil if none found.
+func (m *DailyViews) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyViews) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Views
+
+	if len(errors) > 0 {
+		return DailyViewsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyViewsMultiError is an error wrapping multiple validation errors
+// returned by DailyViews.ValidateAll() if the designated constraints aren't met.
+type DailyViewsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyViewsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyViewsMultiError) AllErrors() []error { return m }
+
+// DailyViewsValidationError is the validation error returned by
+// DailyViews.Validate if the designated constraints aren't met.
+type DailyViewsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyViewsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyViewsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyViewsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyViewsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyViewsValidationError) ErrorName() string { return "DailyViewsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DailyViewsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyViews.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyViewsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyViewsValidationError{}
+
+// Validate checks the field values on GetBlogViewStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBlogViewStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBlogViewStatsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBlogViewStatsResponseMultiError, or nil if none found.
+func (m *GetBlogViewStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBlogViewStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetBlogViewStatsResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetBlogViewStatsResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetBlogViewStatsResponseValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalViews
+
+	if len(errors) > 0 {
+		return GetBlogViewStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBlogViewStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBlogViewStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBlogViewStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBlogViewStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBlogViewStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetBlogViewStatsResponseValidationError is the validation error returned by
+// GetBlogViewStatsResponse.Validate if the designated constraints aren't met.
+type GetBlogViewStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBlogViewStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBlogViewStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBlogViewStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBlogViewStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBlogViewStatsResponseValidationError) ErrorName() string {
+	return "GetBlogViewStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBlogViewStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBlogViewStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBlogViewStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBlogViewStatsResponseValidationError{}
@@ -0,0 +1,770 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: comment.proto
+
+package pb
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CommentStatus int32
+
+const (
+	CommentStatus_COMMENT_STATUS_UNSPECIFIED CommentStatus = 0
+	// COMMENT_STATUS_PENDING comments wait for moderation, new comments are pending
+	CommentStatus_COMMENT_STATUS_PENDING  CommentStatus = 1
+	CommentStatus_COMMENT_STATUS_APPROVED CommentStatus = 2
+	CommentStatus_COMMENT_STATUS_REJECTED CommentStatus = 3
+	CommentStatus_COMMENT_STATUS_SPAM     CommentStatus = 4
+)
+
+// Enum value maps for CommentStatus.
+var (
+	CommentStatus_name = map[int32]string{
+		0: "COMMENT_STATUS_UNSPECIFIED",
+		1: "COMMENT_STATUS_PENDING",
+		2: "COMMENT_STATUS_APPROVED",
+		3: "COMMENT_STATUS_REJECTED",
+		4: "COMMENT_STATUS_SPAM",
+	}
+	CommentStatus_value = map[string]int32{
+		"COMMENT_STATUS_UNSPECIFIED": 0,
+		"COMMENT_STATUS_PENDING":     1,
+		"COMMENT_STATUS_APPROVED":    2,
+		"COMMENT_STATUS_REJECTED":    3,
+		"COMMENT_STATUS_SPAM":        4,
+	}
+)
+
+func (x CommentStatus) Enum() *CommentStatus {
+	p := new(CommentStatus)
+	*p = x
+	return p
+}
+
+func (x CommentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_proto_enumTypes[0].Descriptor()
+}
+
+func (CommentStatus) Type() protoreflect.EnumType {
+	return &file_comment_proto_enumTypes[0]
+}
+
+func (x CommentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentStatus.Descriptor instead.
+func (CommentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{0}
+}
+
+type ListCommentsRequest_Mode int32
+
+const (
+	// MODE_UNSPECIFIED lists the comments as a tree
+	ListCommentsRequest_MODE_UNSPECIFIED ListCommentsRequest_Mode = 0
+	// MODE_TREE pages the comments of the first level, each with all its
+	// replies nested in replies
+	ListCommentsRequest_MODE_TREE ListCommentsRequest_Mode = 1
+	// MODE_FLAT pages all the comments, oldest first, with their depth
+	ListCommentsRequest_MODE_FLAT ListCommentsRequest_Mode = 2
+)
+
+// Enum value maps for ListCommentsRequest_Mode.
+var (
+	ListCommentsRequest_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_TREE",
+		2: "MODE_FLAT",
+	}
+	ListCommentsRequest_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_TREE":        1,
+		"MODE_FLAT":        2,
+	}
+)
+
+func (x ListCommentsRequest_Mode) Enum() *ListCommentsRequest_Mode {
+	p := new(ListCommentsRequest_Mode)
+	*p = x
+	return p
+}
+
+func (x ListCommentsRequest_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListCommentsRequest_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_comment_proto_enumTypes[1].Descriptor()
+}
+
+func (ListCommentsRequest_Mode) Type() protoreflect.EnumType {
+	return &file_comment_proto_enumTypes[1]
+}
+
+func (x ListCommentsRequest_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListCommentsRequest_Mode.Descriptor instead.
+func (ListCommentsRequest_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type Comment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlogId uint32                 `protobuf:"varint,2,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// parent_id is the comment replied to, 0 for top level comments
+	ParentId   uint32                 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	AuthorName string                 `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Body       string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Status     CommentStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=pb.CommentStatus" json:"status,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// depth is the number of levels below the first listed level, it is only
+	// set by ListComments
+	Depth uint32 `protobuf:"varint,9,opt,name=depth,proto3" json:"depth,omitempty"`
+	// replies are only set by ListComments in tree mode, oldest first
+	Replies       []*Comment `protobuf:"bytes,10,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_comment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Comment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *Comment) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_COMMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+type CreateCommentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BlogId uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// parent_id is an approved comment of the same blog to reply to
+	ParentId      uint32 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	AuthorName    string `protobuf:"bytes,3,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_comment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCommentRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCommentRequest) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type CreateCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentResponse) Reset() {
+	*x = CreateCommentResponse{}
+	mi := &file_comment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentResponse) ProtoMessage() {}
+
+func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCommentResponse) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentRequest) Reset() {
+	*x = GetCommentRequest{}
+	mi := &file_comment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentRequest) ProtoMessage() {}
+
+func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCommentRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListCommentsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	BlogId uint32                 `protobuf:"varint,1,opt,name=blog_id,json=blogId,proto3" json:"blog_id,omitempty"`
+	// parent_id lists the replies of the comment instead of the top level comments
+	ParentId uint32                   `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Mode     ListCommentsRequest_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=pb.ListCommentsRequest_Mode" json:"mode,omitempty"`
+	// statuses restricts the comments listed, only approved comments are
+	// listed when empty. Replies of comments that are not listed are not
+	// listed either.
+	Statuses      []CommentStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=pb.CommentStatus" json:"statuses,omitempty"`
+	Limit         int32           `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32           `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_comment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCommentsRequest) GetBlogId() uint32 {
+	if x != nil {
+		return x.BlogId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetMode() ListCommentsRequest_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return ListCommentsRequest_MODE_UNSPECIFIED
+}
+
+func (x *ListCommentsRequest) GetStatuses() []CommentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListCommentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Comment             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalItems    int64                  `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_comment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCommentsResponse) GetItems() []*Comment {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCommentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCommentsResponse) GetTotalItems() int64 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListCommentsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type ModerateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        CommentStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=pb.CommentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentRequest) Reset() {
+	*x = ModerateCommentRequest{}
+	mi := &file_comment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentRequest) ProtoMessage() {}
+
+func (x *ModerateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_comment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_comment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ModerateCommentRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateCommentRequest) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_COMMENT_STATUS_UNSPECIFIED
+}
+
+var File_comment_proto protoreflect.FileDescriptor
+
+const file_comment_proto_rawDesc = "" +
+	"\n" +
+	"\rcomment.proto\x12\x02pb\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe2\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\ablog_id\x18\x02 \x01(\rR\x06blogId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\rR\bparentId\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12)\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x11.pb.CommentStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
+	"\x05depth\x18\t \x01(\rR\x05depth\x12%\n" +
+	"\areplies\x18\n" +
+	" \x03(\v2\v.pb.CommentR\areplies\"\xa1\x01\n" +
+	"\x14CreateCommentRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\rR\bparentId\x12*\n" +
+	"\vauthor_name\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\n" +
+	"authorName\x12\x1e\n" +
+	"\x04body\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xd0\x0fR\x04body\"'\n" +
+	"\x15CreateCommentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\",\n" +
+	"\x11GetCommentRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x02id\"\xc1\x02\n" +
+	"\x13ListCommentsRequest\x12 \n" +
+	"\ablog_id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x06blogId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\rR\bparentId\x12:\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x1c.pb.ListCommentsRequest.ModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode\x12@\n" +
+	"\bstatuses\x18\x04 \x03(\x0e2\x11.pb.CommentStatusB\x11\xbaH\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\bstatuses\x12\x1d\n" +
+	"\x05limit\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02\x10dR\x05limit\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\":\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tMODE_TREE\x10\x01\x12\r\n" +
+	"\tMODE_FLAT\x10\x02\"\xa5\x01\n" +
+	"\x14ListCommentsResponse\x12!\n" +
+	"\x05items\x18\x01 \x03(\v2\v.pb.CommentR\x05items\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x03R\n" +
+	"totalItems\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"h\n" +
+	"\x16ModerateCommentRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x01R\x02id\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x11.pb.CommentStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status*\x9e\x01\n" +
+	"\rCommentStatus\x12\x1e\n" +
+	"\x1aCOMMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16COMMENT_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17COMMENT_STATUS_APPROVED\x10\x02\x12\x1b\n" +
+	"\x17COMMENT_STATUS_REJECTED\x10\x03\x12\x17\n" +
+	"\x13COMMENT_STATUS_SPAM\x10\x042\x94\x02\n" +
+	"\bComments\x12F\n" +
+	"\rCreateComment\x12\x18.pb.CreateCommentRequest\x1a\x19.pb.CreateCommentResponse\"\x00\x122\n" +
+	"\n" +
+	"GetComment\x12\x15.pb.GetCommentRequest\x1a\v.pb.Comment\"\x00\x12C\n" +
+	"\fListComments\x12\x17.pb.ListCommentsRequest\x1a\x18.pb.ListCommentsResponse\"\x00\x12G\n" +
+	"\x0fModerateComment\x12\x1a.pb.ModerateCommentRequest\x1a\x16.google.protobuf.Empty\"\x00B\x06Z\x04./pbb\x06proto3"
+
+var (
+	file_comment_proto_rawDescOnce sync.Once
+	file_comment_proto_rawDescData []byte
+)
+
+func file_comment_proto_rawDescGZIP() []byte {
+	file_comment_proto_rawDescOnce.Do(func() {
+		file_comment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_comment_proto_rawDesc), len(file_comment_proto_rawDesc)))
+	})
+	return file_comment_proto_rawDescData
+}
+
+var file_comment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_comment_proto_goTypes = []any{
+	(CommentStatus)(0),             // 0: pb.CommentStatus
+	(ListCommentsRequest_Mode)(0),  // 1: pb.ListCommentsRequest.Mode
+	(*Comment)(nil),                // 2: pb.Comment
+	(*CreateCommentRequest)(nil),   // 3: pb.CreateCommentRequest
+	(*CreateCommentResponse)(nil),  // 4: pb.CreateCommentResponse
+	(*GetCommentRequest)(nil),      // 5: pb.GetCommentRequest
+	(*ListCommentsRequest)(nil),    // 6: pb.ListCommentsRequest
+	(*ListCommentsResponse)(nil),   // 7: pb.ListCommentsResponse
+	(*ModerateCommentRequest)(nil), // 8: pb.ModerateCommentRequest
+	(*timestamppb.Timestamp)(nil),  // 9: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 10: google.protobuf.Empty
+}
+var file_comment_proto_depIdxs = []int32{
+	0,  // 0: pb.Comment.status:type_name -> pb.CommentStatus
+	9,  // 1: pb.Comment.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: pb.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: pb.Comment.replies:type_name -> pb.Comment
+	1,  // 4: pb.ListCommentsRequest.mode:type_name -> pb.ListCommentsRequest.Mode
+	0,  // 5: pb.ListCommentsRequest.statuses:type_name -> pb.CommentStatus
+	2,  // 6: pb.ListCommentsResponse.items:type_name -> pb.Comment
+	0,  // 7: pb.ModerateCommentRequest.status:type_name -> pb.CommentStatus
+	3,  // 8: pb.Comments.CreateComment:input_type -> pb.CreateCommentRequest
+	5,  // 9: pb.Comments.GetComment:input_type -> pb.GetCommentRequest
+	6,  // 10: pb.Comments.ListComments:input_type -> pb.ListCommentsRequest
+	8,  // 11: pb.Comments.ModerateComment:input_type -> pb.ModerateCommentRequest
+	4,  // 12: pb.Comments.CreateComment:output_type -> pb.CreateCommentResponse
+	2,  // 13: pb.Comments.GetComment:output_type -> pb.Comment
+	7,  // 14: pb.Comments.ListComments:output_type -> pb.ListCommentsResponse
+	10, // 15: pb.Comments.ModerateComment:output_type -> google.protobuf.Empty
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_comment_proto_init() }
+func file_comment_proto_init() {
+	if File_comment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_comment_proto_rawDesc), len(file_comment_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_comment_proto_goTypes,
+		DependencyIndexes: file_comment_proto_depIdxs,
+		EnumInfos:         file_comment_proto_enumTypes,
+		MessageInfos:      file_comment_proto_msgTypes,
+	}.Build()
+	File_comment_proto = out.File
+	file_comment_proto_goTypes = nil
+	file_comment_proto_depIdxs = nil
+}
@@ -0,0 +1,1132 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: blog.proto
+
+package pbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	pb "github.com/susana-garcia/go-crud/pb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BloggerName is the fully-qualified name of the Blogger service.
+	BloggerName = "pb.Blogger"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BloggerGetBlogProcedure is the fully-qualified name of the Blogger's GetBlog RPC.
+	BloggerGetBlogProcedure = "/pb.Blogger/GetBlog"
+	// BloggerGetBlogsProcedure is the fully-qualified name of the Blogger's GetBlogs RPC.
+	BloggerGetBlogsProcedure = "/pb.Blogger/GetBlogs"
+	// BloggerCreateBlogProcedure is the fully-qualified name of the Blogger's CreateBlog RPC.
+	BloggerCreateBlogProcedure = "/pb.Blogger/CreateBlog"
+	// BloggerUpdateBlogProcedure is the fully-qualified name of the Blogger's UpdateBlog RPC.
+	BloggerUpdateBlogProcedure = "/pb.Blogger/UpdateBlog"
+	// BloggerDeleteBlogProcedure is the fully-qualified name of the Blogger's DeleteBlog RPC.
+	BloggerDeleteBlogProcedure = "/pb.Blogger/DeleteBlog"
+	// BloggerSearchBlogsProcedure is the fully-qualified name of the Blogger's SearchBlogs RPC.
+	BloggerSearchBlogsProcedure = "/pb.Blogger/SearchBlogs"
+	// BloggerBatchGetBlogsProcedure is the fully-qualified name of the Blogger's BatchGetBlogs RPC.
+	BloggerBatchGetBlogsProcedure = "/pb.Blogger/BatchGetBlogs"
+	// BloggerBatchCreateBlogsProcedure is the fully-qualified name of the Blogger's BatchCreateBlogs
+	// RPC.
+	BloggerBatchCreateBlogsProcedure = "/pb.Blogger/BatchCreateBlogs"
+	// BloggerBatchDeleteBlogsProcedure is the fully-qualified name of the Blogger's BatchDeleteBlogs
+	// RPC.
+	BloggerBatchDeleteBlogsProcedure = "/pb.Blogger/BatchDeleteBlogs"
+	// BloggerWatchBlogsProcedure is the fully-qualified name of the Blogger's WatchBlogs RPC.
+	BloggerWatchBlogsProcedure = "/pb.Blogger/WatchBlogs"
+	// BloggerImportBlogsProcedure is the fully-qualified name of the Blogger's ImportBlogs RPC.
+	BloggerImportBlogsProcedure = "/pb.Blogger/ImportBlogs"
+	// BloggerExportBlogsProcedure is the fully-qualified name of the Blogger's ExportBlogs RPC.
+	BloggerExportBlogsProcedure = "/pb.Blogger/ExportBlogs"
+	// BloggerUndeleteBlogProcedure is the fully-qualified name of the Blogger's UndeleteBlog RPC.
+	BloggerUndeleteBlogProcedure = "/pb.Blogger/UndeleteBlog"
+	// BloggerListDeletedBlogsProcedure is the fully-qualified name of the Blogger's ListDeletedBlogs
+	// RPC.
+	BloggerListDeletedBlogsProcedure = "/pb.Blogger/ListDeletedBlogs"
+	// BloggerListBlogRevisionsProcedure is the fully-qualified name of the Blogger's ListBlogRevisions
+	// RPC.
+	BloggerListBlogRevisionsProcedure = "/pb.Blogger/ListBlogRevisions"
+	// BloggerGetBlogRevisionProcedure is the fully-qualified name of the Blogger's GetBlogRevision RPC.
+	BloggerGetBlogRevisionProcedure = "/pb.Blogger/GetBlogRevision"
+	// BloggerRestoreBlogRevisionProcedure is the fully-qualified name of the Blogger's
+	// RestoreBlogRevision RPC.
+	BloggerRestoreBlogRevisionProcedure = "/pb.Blogger/RestoreBlogRevision"
+	// BloggerDiffBlogRevisionsProcedure is the fully-qualified name of the Blogger's DiffBlogRevisions
+	// RPC.
+	BloggerDiffBlogRevisionsProcedure = "/pb.Blogger/DiffBlogRevisions"
+	// BloggerPublishBlogProcedure is the fully-qualified name of the Blogger's PublishBlog RPC.
+	BloggerPublishBlogProcedure = "/pb.Blogger/PublishBlog"
+	// BloggerUnpublishBlogProcedure is the fully-qualified name of the Blogger's UnpublishBlog RPC.
+	BloggerUnpublishBlogProcedure = "/pb.Blogger/UnpublishBlog"
+	// BloggerListTagsProcedure is the fully-qualified name of the Blogger's ListTags RPC.
+	BloggerListTagsProcedure = "/pb.Blogger/ListTags"
+	// BloggerRenameTagProcedure is the fully-qualified name of the Blogger's RenameTag RPC.
+	BloggerRenameTagProcedure = "/pb.Blogger/RenameTag"
+	// BloggerMergeTagsProcedure is the fully-qualified name of the Blogger's MergeTags RPC.
+	BloggerMergeTagsProcedure = "/pb.Blogger/MergeTags"
+	// BloggerCreateAuthorProcedure is the fully-qualified name of the Blogger's CreateAuthor RPC.
+	BloggerCreateAuthorProcedure = "/pb.Blogger/CreateAuthor"
+	// BloggerGetAuthorProcedure is the fully-qualified name of the Blogger's GetAuthor RPC.
+	BloggerGetAuthorProcedure = "/pb.Blogger/GetAuthor"
+	// BloggerListAuthorsProcedure is the fully-qualified name of the Blogger's ListAuthors RPC.
+	BloggerListAuthorsProcedure = "/pb.Blogger/ListAuthors"
+	// BloggerUpdateAuthorProcedure is the fully-qualified name of the Blogger's UpdateAuthor RPC.
+	BloggerUpdateAuthorProcedure = "/pb.Blogger/UpdateAuthor"
+	// BloggerDeleteAuthorProcedure is the fully-qualified name of the Blogger's DeleteAuthor RPC.
+	BloggerDeleteAuthorProcedure = "/pb.Blogger/DeleteAuthor"
+	// BloggerAddReactionProcedure is the fully-qualified name of the Blogger's AddReaction RPC.
+	BloggerAddReactionProcedure = "/pb.Blogger/AddReaction"
+	// BloggerRemoveReactionProcedure is the fully-qualified name of the Blogger's RemoveReaction RPC.
+	BloggerRemoveReactionProcedure = "/pb.Blogger/RemoveReaction"
+	// BloggerListReactionsProcedure is the fully-qualified name of the Blogger's ListReactions RPC.
+	BloggerListReactionsProcedure = "/pb.Blogger/ListReactions"
+	// BloggerRecordViewProcedure is the fully-qualified name of the Blogger's RecordView RPC.
+	BloggerRecordViewProcedure = "/pb.Blogger/RecordView"
+	// BloggerGetBlogViewStatsProcedure is the fully-qualified name of the Blogger's GetBlogViewStats
+	// RPC.
+	BloggerGetBlogViewStatsProcedure = "/pb.Blogger/GetBlogViewStats"
+)
+
+// BloggerClient is a client for the pb.Blogger service.
+type BloggerClient interface {
+	GetBlog(context.Context, *pb.GetBlogRequest) (*pb.GetBlogResponse, error)
+	GetBlogs(context.Context, *pb.GetBlogsRequest) (*pb.GetBlogsResponse, error)
+	CreateBlog(context.Context, *pb.CreateBlogRequest) (*pb.CreateBlogResponse, error)
+	UpdateBlog(context.Context, *pb.UpdateBlogRequest) (*emptypb.Empty, error)
+	DeleteBlog(context.Context, *pb.DeleteBlogRequest) (*emptypb.Empty, error)
+	SearchBlogs(context.Context, *pb.SearchBlogsRequest) (*pb.SearchBlogsResponse, error)
+	BatchGetBlogs(context.Context, *pb.BatchGetBlogsRequest) (*pb.BatchGetBlogsResponse, error)
+	BatchCreateBlogs(context.Context, *pb.BatchCreateBlogsRequest) (*pb.BatchCreateBlogsResponse, error)
+	BatchDeleteBlogs(context.Context, *pb.BatchDeleteBlogsRequest) (*pb.BatchDeleteBlogsResponse, error)
+	WatchBlogs(context.Context, *pb.WatchBlogsRequest) (*connect.ServerStreamForClient[pb.BlogChange], error)
+	ImportBlogs(context.Context) (*connect.ClientStreamForClientSimple[pb.ImportBlogsRequest, pb.ImportBlogsResponse], error)
+	ExportBlogs(context.Context, *pb.ExportBlogsRequest) (*connect.ServerStreamForClient[pb.Blog], error)
+	UndeleteBlog(context.Context, *pb.UndeleteBlogRequest) (*emptypb.Empty, error)
+	ListDeletedBlogs(context.Context, *pb.ListDeletedBlogsRequest) (*pb.ListDeletedBlogsResponse, error)
+	ListBlogRevisions(context.Context, *pb.ListBlogRevisionsRequest) (*pb.ListBlogRevisionsResponse, error)
+	GetBlogRevision(context.Context, *pb.GetBlogRevisionRequest) (*pb.BlogRevision, error)
+	RestoreBlogRevision(context.Context, *pb.RestoreBlogRevisionRequest) (*emptypb.Empty, error)
+	DiffBlogRevisions(context.Context, *pb.DiffBlogRevisionsRequest) (*pb.DiffBlogRevisionsResponse, error)
+	PublishBlog(context.Context, *pb.PublishBlogRequest) (*emptypb.Empty, error)
+	UnpublishBlog(context.Context, *pb.UnpublishBlogRequest) (*emptypb.Empty, error)
+	ListTags(context.Context, *pb.ListTagsRequest) (*pb.ListTagsResponse, error)
+	RenameTag(context.Context, *pb.RenameTagRequest) (*emptypb.Empty, error)
+	MergeTags(context.Context, *pb.MergeTagsRequest) (*emptypb.Empty, error)
+	CreateAuthor(context.Context, *pb.CreateAuthorRequest) (*pb.CreateAuthorResponse, error)
+	GetAuthor(context.Context, *pb.GetAuthorRequest) (*pb.GetAuthorResponse, error)
+	ListAuthors(context.Context, *pb.ListAuthorsRequest) (*pb.ListAuthorsResponse, error)
+	UpdateAuthor(context.Context, *pb.UpdateAuthorRequest) (*emptypb.Empty, error)
+	DeleteAuthor(context.Context, *pb.DeleteAuthorRequest) (*emptypb.Empty, error)
+	AddReaction(context.Context, *pb.AddReactionRequest) (*emptypb.Empty, error)
+	RemoveReaction(context.Context, *pb.RemoveReactionRequest) (*emptypb.Empty, error)
+	ListReactions(context.Context, *pb.ListReactionsRequest) (*pb.ListReactionsResponse, error)
+	RecordView(context.Context, *pb.RecordViewRequest) (*emptypb.Empty, error)
+	GetBlogViewStats(context.Context, *pb.GetBlogViewStatsRequest) (*pb.GetBlogViewStatsResponse, error)
+}
+
+// NewBloggerClient constructs a client for the pb.Blogger service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBloggerClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BloggerClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	bloggerMethods := pb.File_blog_proto.Services().ByName("Blogger").Methods()
+	return &bloggerClient{
+		getBlog: connect.NewClient[pb.GetBlogRequest, pb.GetBlogResponse](
+			httpClient,
+			baseURL+BloggerGetBlogProcedure,
+			connect.WithSchema(bloggerMethods.ByName("GetBlog")),
+			connect.WithClientOptions(opts...),
+		),
+		getBlogs: connect.NewClient[pb.GetBlogsRequest, pb.GetBlogsResponse](
+			httpClient,
+			baseURL+BloggerGetBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("GetBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		createBlog: connect.NewClient[pb.CreateBlogRequest, pb.CreateBlogResponse](
+			httpClient,
+			baseURL+BloggerCreateBlogProcedure,
+			connect.WithSchema(bloggerMethods.ByName("CreateBlog")),
+			connect.WithClientOptions(opts...),
+		),
+		updateBlog: connect.NewClient[pb.UpdateBlogRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerUpdateBlogProcedure,
+			connect.WithSchema(bloggerMethods.ByName("UpdateBlog")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteBlog: connect.NewClient[pb.DeleteBlogRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerDeleteBlogProcedure,
+			connect.WithSchema(bloggerMethods.ByName("DeleteBlog")),
+			connect.WithClientOptions(opts...),
+		),
+		searchBlogs: connect.NewClient[pb.SearchBlogsRequest, pb.SearchBlogsResponse](
+			httpClient,
+			baseURL+BloggerSearchBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("SearchBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		batchGetBlogs: connect.NewClient[pb.BatchGetBlogsRequest, pb.BatchGetBlogsResponse](
+			httpClient,
+			baseURL+BloggerBatchGetBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("BatchGetBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		batchCreateBlogs: connect.NewClient[pb.BatchCreateBlogsRequest, pb.BatchCreateBlogsResponse](
+			httpClient,
+			baseURL+BloggerBatchCreateBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("BatchCreateBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		batchDeleteBlogs: connect.NewClient[pb.BatchDeleteBlogsRequest, pb.BatchDeleteBlogsResponse](
+			httpClient,
+			baseURL+BloggerBatchDeleteBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("BatchDeleteBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		watchBlogs: connect.NewClient[pb.WatchBlogsRequest, pb.BlogChange](
+			httpClient,
+			baseURL+BloggerWatchBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("WatchBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		importBlogs: connect.NewClient[pb.ImportBlogsRequest, pb.ImportBlogsResponse](
+			httpClient,
+			baseURL+BloggerImportBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("ImportBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		exportBlogs: connect.NewClient[pb.ExportBlogsRequest, pb.Blog](
+			httpClient,
+			baseURL+BloggerExportBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("ExportBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		undeleteBlog: connect.NewClient[pb.UndeleteBlogRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerUndeleteBlogProcedure,
+			connect.WithSchema(bloggerMethods.ByName("UndeleteBlog")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedBlogs: connect.NewClient[pb.ListDeletedBlogsRequest, pb.ListDeletedBlogsResponse](
+			httpClient,
+			baseURL+BloggerListDeletedBlogsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("ListDeletedBlogs")),
+			connect.WithClientOptions(opts...),
+		),
+		listBlogRevisions: connect.NewClient[pb.ListBlogRevisionsRequest, pb.ListBlogRevisionsResponse](
+			httpClient,
+			baseURL+BloggerListBlogRevisionsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("ListBlogRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		getBlogRevision: connect.NewClient[pb.GetBlogRevisionRequest, pb.BlogRevision](
+			httpClient,
+			baseURL+BloggerGetBlogRevisionProcedure,
+			connect.WithSchema(bloggerMethods.ByName("GetBlogRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreBlogRevision: connect.NewClient[pb.RestoreBlogRevisionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerRestoreBlogRevisionProcedure,
+			connect.WithSchema(bloggerMethods.ByName("RestoreBlogRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		diffBlogRevisions: connect.NewClient[pb.DiffBlogRevisionsRequest, pb.DiffBlogRevisionsResponse](
+			httpClient,
+			baseURL+BloggerDiffBlogRevisionsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("DiffBlogRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		publishBlog: connect.NewClient[pb.PublishBlogRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerPublishBlogProcedure,
+			connect.WithSchema(bloggerMethods.ByName("PublishBlog")),
+			connect.WithClientOptions(opts...),
+		),
+		unpublishBlog: connect.NewClient[pb.UnpublishBlogRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerUnpublishBlogProcedure,
+			connect.WithSchema(bloggerMethods.ByName("UnpublishBlog")),
+			connect.WithClientOptions(opts...),
+		),
+		listTags: connect.NewClient[pb.ListTagsRequest, pb.ListTagsResponse](
+			httpClient,
+			baseURL+BloggerListTagsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
+		renameTag: connect.NewClient[pb.RenameTagRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerRenameTagProcedure,
+			connect.WithSchema(bloggerMethods.ByName("RenameTag")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeTags: connect.NewClient[pb.MergeTagsRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerMergeTagsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("MergeTags")),
+			connect.WithClientOptions(opts...),
+		),
+		createAuthor: connect.NewClient[pb.CreateAuthorRequest, pb.CreateAuthorResponse](
+			httpClient,
+			baseURL+BloggerCreateAuthorProcedure,
+			connect.WithSchema(bloggerMethods.ByName("CreateAuthor")),
+			connect.WithClientOptions(opts...),
+		),
+		getAuthor: connect.NewClient[pb.GetAuthorRequest, pb.GetAuthorResponse](
+			httpClient,
+			baseURL+BloggerGetAuthorProcedure,
+			connect.WithSchema(bloggerMethods.ByName("GetAuthor")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuthors: connect.NewClient[pb.ListAuthorsRequest, pb.ListAuthorsResponse](
+			httpClient,
+			baseURL+BloggerListAuthorsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("ListAuthors")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAuthor: connect.NewClient[pb.UpdateAuthorRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerUpdateAuthorProcedure,
+			connect.WithSchema(bloggerMethods.ByName("UpdateAuthor")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAuthor: connect.NewClient[pb.DeleteAuthorRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerDeleteAuthorProcedure,
+			connect.WithSchema(bloggerMethods.ByName("DeleteAuthor")),
+			connect.WithClientOptions(opts...),
+		),
+		addReaction: connect.NewClient[pb.AddReactionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerAddReactionProcedure,
+			connect.WithSchema(bloggerMethods.ByName("AddReaction")),
+			connect.WithClientOptions(opts...),
+		),
+		removeReaction: connect.NewClient[pb.RemoveReactionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerRemoveReactionProcedure,
+			connect.WithSchema(bloggerMethods.ByName("RemoveReaction")),
+			connect.WithClientOptions(opts...),
+		),
+		listReactions: connect.NewClient[pb.ListReactionsRequest, pb.ListReactionsResponse](
+			httpClient,
+			baseURL+BloggerListReactionsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("ListReactions")),
+			connect.WithClientOptions(opts...),
+		),
+		recordView: connect.NewClient[pb.RecordViewRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BloggerRecordViewProcedure,
+			connect.WithSchema(bloggerMethods.ByName("RecordView")),
+			connect.WithClientOptions(opts...),
+		),
+		getBlogViewStats: connect.NewClient[pb.GetBlogViewStatsRequest, pb.GetBlogViewStatsResponse](
+			httpClient,
+			baseURL+BloggerGetBlogViewStatsProcedure,
+			connect.WithSchema(bloggerMethods.ByName("GetBlogViewStats")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// bloggerClient implements BloggerClient.
+type bloggerClient struct {
+	getBlog             *connect.Client[pb.GetBlogRequest, pb.GetBlogResponse]
+	getBlogs            *connect.Client[pb.GetBlogsRequest, pb.GetBlogsResponse]
+	createBlog          *connect.Client[pb.CreateBlogRequest, pb.CreateBlogResponse]
+	updateBlog          *connect.Client[pb.UpdateBlogRequest, emptypb.Empty]
+	deleteBlog          *connect.Client[pb.DeleteBlogRequest, emptypb.Empty]
+	searchBlogs         *connect.Client[pb.SearchBlogsRequest, pb.SearchBlogsResponse]
+	batchGetBlogs       *connect.Client[pb.BatchGetBlogsRequest, pb.BatchGetBlogsResponse]
+	batchCreateBlogs    *connect.Client[pb.BatchCreateBlogsRequest, pb.BatchCreateBlogsResponse]
+	batchDeleteBlogs    *connect.Client[pb.BatchDeleteBlogsRequest, pb.BatchDeleteBlogsResponse]
+	watchBlogs          *connect.Client[pb.WatchBlogsRequest, pb.BlogChange]
+	importBlogs         *connect.Client[pb.ImportBlogsRequest, pb.ImportBlogsResponse]
+	exportBlogs         *connect.Client[pb.ExportBlogsRequest, pb.Blog]
+	undeleteBlog        *connect.Client[pb.UndeleteBlogRequest, emptypb.Empty]
+	listDeletedBlogs    *connect.Client[pb.ListDeletedBlogsRequest, pb.ListDeletedBlogsResponse]
+	listBlogRevisions   *connect.Client[pb.ListBlogRevisionsRequest, pb.ListBlogRevisionsResponse]
+	getBlogRevision     *connect.Client[pb.GetBlogRevisionRequest, pb.BlogRevision]
+	restoreBlogRevision *connect.Client[pb.RestoreBlogRevisionRequest, emptypb.Empty]
+	diffBlogRevisions   *connect.Client[pb.DiffBlogRevisionsRequest, pb.DiffBlogRevisionsResponse]
+	publishBlog         *connect.Client[pb.PublishBlogRequest, emptypb.Empty]
+	unpublishBlog       *connect.Client[pb.UnpublishBlogRequest, emptypb.Empty]
+	listTags            *connect.Client[pb.ListTagsRequest, pb.ListTagsResponse]
+	renameTag           *connect.Client[pb.RenameTagRequest, emptypb.Empty]
+	mergeTags           *connect.Client[pb.MergeTagsRequest, emptypb.Empty]
+	createAuthor        *connect.Client[pb.CreateAuthorRequest, pb.CreateAuthorResponse]
+	getAuthor           *connect.Client[pb.GetAuthorRequest, pb.GetAuthorResponse]
+	listAuthors         *connect.Client[pb.ListAuthorsRequest, pb.ListAuthorsResponse]
+	updateAuthor        *connect.Client[pb.UpdateAuthorRequest, emptypb.Empty]
+	deleteAuthor        *connect.Client[pb.DeleteAuthorRequest, emptypb.Empty]
+	addReaction         *connect.Client[pb.AddReactionRequest, emptypb.Empty]
+	removeReaction      *connect.Client[pb.RemoveReactionRequest, emptypb.Empty]
+	listReactions       *connect.Client[pb.ListReactionsRequest, pb.ListReactionsResponse]
+	recordView          *connect.Client[pb.RecordViewRequest, emptypb.Empty]
+	getBlogViewStats    *connect.Client[pb.GetBlogViewStatsRequest, pb.GetBlogViewStatsResponse]
+}
+
+// GetBlog calls pb.Blogger.GetBlog.
+func (c *bloggerClient) GetBlog(ctx context.Context, req *pb.GetBlogRequest) (*pb.GetBlogResponse, error) {
+	response, err := c.getBlog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetBlogs calls pb.Blogger.GetBlogs.
+func (c *bloggerClient) GetBlogs(ctx context.Context, req *pb.GetBlogsRequest) (*pb.GetBlogsResponse, error) {
+	response, err := c.getBlogs.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateBlog calls pb.Blogger.CreateBlog.
+func (c *bloggerClient) CreateBlog(ctx context.Context, req *pb.CreateBlogRequest) (*pb.CreateBlogResponse, error) {
+	response, err := c.createBlog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateBlog calls pb.Blogger.UpdateBlog.
+func (c *bloggerClient) UpdateBlog(ctx context.Context, req *pb.UpdateBlogRequest) (*emptypb.Empty, error) {
+	response, err := c.updateBlog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteBlog calls pb.Blogger.DeleteBlog.
+func (c *bloggerClient) DeleteBlog(ctx context.Context, req *pb.DeleteBlogRequest) (*emptypb.Empty, error) {
+	response, err := c.deleteBlog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchBlogs calls pb.Blogger.SearchBlogs.
+func (c *bloggerClient) SearchBlogs(ctx context.Context, req *pb.SearchBlogsRequest) (*pb.SearchBlogsResponse, error) {
+	response, err := c.searchBlogs.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchGetBlogs calls pb.Blogger.BatchGetBlogs.
+func (c *bloggerClient) BatchGetBlogs(ctx context.Context, req *pb.BatchGetBlogsRequest) (*pb.BatchGetBlogsResponse, error) {
+	response, err := c.batchGetBlogs.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchCreateBlogs calls pb.Blogger.BatchCreateBlogs.
+func (c *bloggerClient) BatchCreateBlogs(ctx context.Context, req *pb.BatchCreateBlogsRequest) (*pb.BatchCreateBlogsResponse, error) {
+	response, err := c.batchCreateBlogs.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchDeleteBlogs calls pb.Blogger.BatchDeleteBlogs.
+func (c *bloggerClient) BatchDeleteBlogs(ctx context.Context, req *pb.BatchDeleteBlogsRequest) (*pb.BatchDeleteBlogsResponse, error) {
+	response, err := c.batchDeleteBlogs.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// WatchBlogs calls pb.Blogger.WatchBlogs.
+func (c *bloggerClient) WatchBlogs(ctx context.Context, req *pb.WatchBlogsRequest) (*connect.ServerStreamForClient[pb.BlogChange], error) {
+	return c.watchBlogs.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// ImportBlogs calls pb.Blogger.ImportBlogs.
+func (c *bloggerClient) ImportBlogs(ctx context.Context) (*connect.ClientStreamForClientSimple[pb.ImportBlogsRequest, pb.ImportBlogsResponse], error) {
+	return c.importBlogs.CallClientStreamSimple(ctx)
+}
+
+// ExportBlogs calls pb.Blogger.ExportBlogs.
+func (c *bloggerClient) ExportBlogs(ctx context.Context, req *pb.ExportBlogsRequest) (*connect.ServerStreamForClient[pb.Blog], error) {
+	return c.exportBlogs.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// UndeleteBlog calls pb.Blogger.UndeleteBlog.
+func (c *bloggerClient) UndeleteBlog(ctx context.Context, req *pb.UndeleteBlogRequest) (*emptypb.Empty, error) {
+	response, err := c.undeleteBlog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListDeletedBlogs calls pb.Blogger.ListDeletedBlogs.
+func (c *bloggerClient) ListDeletedBlogs(ctx context.Context, req *pb.ListDeletedBlogsRequest) (*pb.ListDeletedBlogsResponse, error) {
+	response, err := c.listDeletedBlogs.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListBlogRevisions calls pb.Blogger.ListBlogRevisions.
+func (c *bloggerClient) ListBlogRevisions(ctx context.Context, req *pb.ListBlogRevisionsRequest) (*pb.ListBlogRevisionsResponse, error) {
+	response, err := c.listBlogRevisions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetBlogRevision calls pb.Blogger.GetBlogRevision.
+func (c *bloggerClient) GetBlogRevision(ctx context.Context, req *pb.GetBlogRevisionRequest) (*pb.BlogRevision, error) {
+	response, err := c.getBlogRevision.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreBlogRevision calls pb.Blogger.RestoreBlogRevision.
+func (c *bloggerClient) RestoreBlogRevision(ctx context.Context, req *pb.RestoreBlogRevisionRequest) (*emptypb.Empty, error) {
+	response, err := c.restoreBlogRevision.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DiffBlogRevisions calls pb.Blogger.DiffBlogRevisions.
+func (c *bloggerClient) DiffBlogRevisions(ctx context.Context, req *pb.DiffBlogRevisionsRequest) (*pb.DiffBlogRevisionsResponse, error) {
+	response, err := c.diffBlogRevisions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PublishBlog calls pb.Blogger.PublishBlog.
+func (c *bloggerClient) PublishBlog(ctx context.Context, req *pb.PublishBlogRequest) (*emptypb.Empty, error) {
+	response, err := c.publishBlog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UnpublishBlog calls pb.Blogger.UnpublishBlog.
+func (c *bloggerClient) UnpublishBlog(ctx context.Context, req *pb.UnpublishBlogRequest) (*emptypb.Empty, error) {
+	response, err := c.unpublishBlog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTags calls pb.Blogger.ListTags.
+func (c *bloggerClient) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	response, err := c.listTags.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RenameTag calls pb.Blogger.RenameTag.
+func (c *bloggerClient) RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*emptypb.Empty, error) {
+	response, err := c.renameTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// MergeTags calls pb.Blogger.MergeTags.
+func (c *bloggerClient) MergeTags(ctx context.Context, req *pb.MergeTagsRequest) (*emptypb.Empty, error) {
+	response, err := c.mergeTags.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateAuthor calls pb.Blogger.CreateAuthor.
+func (c *bloggerClient) CreateAuthor(ctx context.Context, req *pb.CreateAuthorRequest) (*pb.CreateAuthorResponse, error) {
+	response, err := c.createAuthor.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAuthor calls pb.Blogger.GetAuthor.
+func (c *bloggerClient) GetAuthor(ctx context.Context, req *pb.GetAuthorRequest) (*pb.GetAuthorResponse, error) {
+	response, err := c.getAuthor.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAuthors calls pb.Blogger.ListAuthors.
+func (c *bloggerClient) ListAuthors(ctx context.Context, req *pb.ListAuthorsRequest) (*pb.ListAuthorsResponse, error) {
+	response, err := c.listAuthors.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateAuthor calls pb.Blogger.UpdateAuthor.
+func (c *bloggerClient) UpdateAuthor(ctx context.Context, req *pb.UpdateAuthorRequest) (*emptypb.Empty, error) {
+	response, err := c.updateAuthor.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteAuthor calls pb.Blogger.DeleteAuthor.
+func (c *bloggerClient) DeleteAuthor(ctx context.Context, req *pb.DeleteAuthorRequest) (*emptypb.Empty, error) {
+	response, err := c.deleteAuthor.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AddReaction calls pb.Blogger.AddReaction.
+func (c *bloggerClient) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*emptypb.Empty, error) {
+	response, err := c.addReaction.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RemoveReaction calls pb.Blogger.RemoveReaction.
+func (c *bloggerClient) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*emptypb.Empty, error) {
+	response, err := c.removeReaction.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListReactions calls pb.Blogger.ListReactions.
+func (c *bloggerClient) ListReactions(ctx context.Context, req *pb.ListReactionsRequest) (*pb.ListReactionsResponse, error) {
+	response, err := c.listReactions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RecordView calls pb.Blogger.RecordView.
+func (c *bloggerClient) RecordView(ctx context.Context, req *pb.RecordViewRequest) (*emptypb.Empty, error) {
+	response, err := c.recordView.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetBlogViewStats calls pb.Blogger.GetBlogViewStats.
+func (c *bloggerClient) GetBlogViewStats(ctx context.Context, req *pb.GetBlogViewStatsRequest) (*pb.GetBlogViewStatsResponse, error) {
+	response, err := c.getBlogViewStats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BloggerHandler is an implementation of the pb.Blogger service.
+type BloggerHandler interface {
+	GetBlog(context.Context, *pb.GetBlogRequest) (*pb.GetBlogResponse, error)
+	GetBlogs(context.Context, *pb.GetBlogsRequest) (*pb.GetBlogsResponse, error)
+	CreateBlog(context.Context, *pb.CreateBlogRequest) (*pb.CreateBlogResponse, error)
+	UpdateBlog(context.Context, *pb.UpdateBlogRequest) (*emptypb.Empty, error)
+	DeleteBlog(context.Context, *pb.DeleteBlogRequest) (*emptypb.Empty, error)
+	SearchBlogs(context.Context, *pb.SearchBlogsRequest) (*pb.SearchBlogsResponse, error)
+	BatchGetBlogs(context.Context, *pb.BatchGetBlogsRequest) (*pb.BatchGetBlogsResponse, error)
+	BatchCreateBlogs(context.Context, *pb.BatchCreateBlogsRequest) (*pb.BatchCreateBlogsResponse, error)
+	BatchDeleteBlogs(context.Context, *pb.BatchDeleteBlogsRequest) (*pb.BatchDeleteBlogsResponse, error)
+	WatchBlogs(context.Context, *pb.WatchBlogsRequest, *connect.ServerStream[pb.BlogChange]) error
+	ImportBlogs(context.Context, *connect.ClientStream[pb.ImportBlogsRequest]) (*pb.ImportBlogsResponse, error)
+	ExportBlogs(context.Context, *pb.ExportBlogsRequest, *connect.ServerStream[pb.Blog]) error
+	UndeleteBlog(context.Context, *pb.UndeleteBlogRequest) (*emptypb.Empty, error)
+	ListDeletedBlogs(context.Context, *pb.ListDeletedBlogsRequest) (*pb.ListDeletedBlogsResponse, error)
+	ListBlogRevisions(context.Context, *pb.ListBlogRevisionsRequest) (*pb.ListBlogRevisionsResponse, error)
+	GetBlogRevision(context.Context, *pb.GetBlogRevisionRequest) (*pb.BlogRevision, error)
+	RestoreBlogRevision(context.Context, *pb.RestoreBlogRevisionRequest) (*emptypb.Empty, error)
+	DiffBlogRevisions(context.Context, *pb.DiffBlogRevisionsRequest) (*pb.DiffBlogRevisionsResponse, error)
+	PublishBlog(context.Context, *pb.PublishBlogRequest) (*emptypb.Empty, error)
+	UnpublishBlog(context.Context, *pb.UnpublishBlogRequest) (*emptypb.Empty, error)
+	ListTags(context.Context, *pb.ListTagsRequest) (*pb.ListTagsResponse, error)
+	RenameTag(context.Context, *pb.RenameTagRequest) (*emptypb.Empty, error)
+	MergeTags(context.Context, *pb.MergeTagsRequest) (*emptypb.Empty, error)
+	CreateAuthor(context.Context, *pb.CreateAuthorRequest) (*pb.CreateAuthorResponse, error)
+	GetAuthor(context.Context, *pb.GetAuthorRequest) (*pb.GetAuthorResponse, error)
+	ListAuthors(context.Context, *pb.ListAuthorsRequest) (*pb.ListAuthorsResponse, error)
+	UpdateAuthor(context.Context, *pb.UpdateAuthorRequest) (*emptypb.Empty, error)
+	DeleteAuthor(context.Context, *pb.DeleteAuthorRequest) (*emptypb.Empty, error)
+	AddReaction(context.Context, *pb.AddReactionRequest) (*emptypb.Empty, error)
+	RemoveReaction(context.Context, *pb.RemoveReactionRequest) (*emptypb.Empty, error)
+	ListReactions(context.Context, *pb.ListReactionsRequest) (*pb.ListReactionsResponse, error)
+	RecordView(context.Context, *pb.RecordViewRequest) (*emptypb.Empty, error)
+	GetBlogViewStats(context.Context, *pb.GetBlogViewStatsRequest) (*pb.GetBlogViewStatsResponse, error)
+}
+
+// NewBloggerHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBloggerHandler(svc BloggerHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	bloggerMethods := pb.File_blog_proto.Services().ByName("Blogger").Methods()
+	bloggerGetBlogHandler := connect.NewUnaryHandlerSimple(
+		BloggerGetBlogProcedure,
+		svc.GetBlog,
+		connect.WithSchema(bloggerMethods.ByName("GetBlog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerGetBlogsHandler := connect.NewUnaryHandlerSimple(
+		BloggerGetBlogsProcedure,
+		svc.GetBlogs,
+		connect.WithSchema(bloggerMethods.ByName("GetBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerCreateBlogHandler := connect.NewUnaryHandlerSimple(
+		BloggerCreateBlogProcedure,
+		svc.CreateBlog,
+		connect.WithSchema(bloggerMethods.ByName("CreateBlog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerUpdateBlogHandler := connect.NewUnaryHandlerSimple(
+		BloggerUpdateBlogProcedure,
+		svc.UpdateBlog,
+		connect.WithSchema(bloggerMethods.ByName("UpdateBlog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerDeleteBlogHandler := connect.NewUnaryHandlerSimple(
+		BloggerDeleteBlogProcedure,
+		svc.DeleteBlog,
+		connect.WithSchema(bloggerMethods.ByName("DeleteBlog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerSearchBlogsHandler := connect.NewUnaryHandlerSimple(
+		BloggerSearchBlogsProcedure,
+		svc.SearchBlogs,
+		connect.WithSchema(bloggerMethods.ByName("SearchBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerBatchGetBlogsHandler := connect.NewUnaryHandlerSimple(
+		BloggerBatchGetBlogsProcedure,
+		svc.BatchGetBlogs,
+		connect.WithSchema(bloggerMethods.ByName("BatchGetBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerBatchCreateBlogsHandler := connect.NewUnaryHandlerSimple(
+		BloggerBatchCreateBlogsProcedure,
+		svc.BatchCreateBlogs,
+		connect.WithSchema(bloggerMethods.ByName("BatchCreateBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerBatchDeleteBlogsHandler := connect.NewUnaryHandlerSimple(
+		BloggerBatchDeleteBlogsProcedure,
+		svc.BatchDeleteBlogs,
+		connect.WithSchema(bloggerMethods.ByName("BatchDeleteBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerWatchBlogsHandler := connect.NewServerStreamHandlerSimple(
+		BloggerWatchBlogsProcedure,
+		svc.WatchBlogs,
+		connect.WithSchema(bloggerMethods.ByName("WatchBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerImportBlogsHandler := connect.NewClientStreamHandlerSimple(
+		BloggerImportBlogsProcedure,
+		svc.ImportBlogs,
+		connect.WithSchema(bloggerMethods.ByName("ImportBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerExportBlogsHandler := connect.NewServerStreamHandlerSimple(
+		BloggerExportBlogsProcedure,
+		svc.ExportBlogs,
+		connect.WithSchema(bloggerMethods.ByName("ExportBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerUndeleteBlogHandler := connect.NewUnaryHandlerSimple(
+		BloggerUndeleteBlogProcedure,
+		svc.UndeleteBlog,
+		connect.WithSchema(bloggerMethods.ByName("UndeleteBlog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerListDeletedBlogsHandler := connect.NewUnaryHandlerSimple(
+		BloggerListDeletedBlogsProcedure,
+		svc.ListDeletedBlogs,
+		connect.WithSchema(bloggerMethods.ByName("ListDeletedBlogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerListBlogRevisionsHandler := connect.NewUnaryHandlerSimple(
+		BloggerListBlogRevisionsProcedure,
+		svc.ListBlogRevisions,
+		connect.WithSchema(bloggerMethods.ByName("ListBlogRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerGetBlogRevisionHandler := connect.NewUnaryHandlerSimple(
+		BloggerGetBlogRevisionProcedure,
+		svc.GetBlogRevision,
+		connect.WithSchema(bloggerMethods.ByName("GetBlogRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerRestoreBlogRevisionHandler := connect.NewUnaryHandlerSimple(
+		BloggerRestoreBlogRevisionProcedure,
+		svc.RestoreBlogRevision,
+		connect.WithSchema(bloggerMethods.ByName("RestoreBlogRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerDiffBlogRevisionsHandler := connect.NewUnaryHandlerSimple(
+		BloggerDiffBlogRevisionsProcedure,
+		svc.DiffBlogRevisions,
+		connect.WithSchema(bloggerMethods.ByName("DiffBlogRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerPublishBlogHandler := connect.NewUnaryHandlerSimple(
+		BloggerPublishBlogProcedure,
+		svc.PublishBlog,
+		connect.WithSchema(bloggerMethods.ByName("PublishBlog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerUnpublishBlogHandler := connect.NewUnaryHandlerSimple(
+		BloggerUnpublishBlogProcedure,
+		svc.UnpublishBlog,
+		connect.WithSchema(bloggerMethods.ByName("UnpublishBlog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerListTagsHandler := connect.NewUnaryHandlerSimple(
+		BloggerListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(bloggerMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerRenameTagHandler := connect.NewUnaryHandlerSimple(
+		BloggerRenameTagProcedure,
+		svc.RenameTag,
+		connect.WithSchema(bloggerMethods.ByName("RenameTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerMergeTagsHandler := connect.NewUnaryHandlerSimple(
+		BloggerMergeTagsProcedure,
+		svc.MergeTags,
+		connect.WithSchema(bloggerMethods.ByName("MergeTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerCreateAuthorHandler := connect.NewUnaryHandlerSimple(
+		BloggerCreateAuthorProcedure,
+		svc.CreateAuthor,
+		connect.WithSchema(bloggerMethods.ByName("CreateAuthor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerGetAuthorHandler := connect.NewUnaryHandlerSimple(
+		BloggerGetAuthorProcedure,
+		svc.GetAuthor,
+		connect.WithSchema(bloggerMethods.ByName("GetAuthor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerListAuthorsHandler := connect.NewUnaryHandlerSimple(
+		BloggerListAuthorsProcedure,
+		svc.ListAuthors,
+		connect.WithSchema(bloggerMethods.ByName("ListAuthors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerUpdateAuthorHandler := connect.NewUnaryHandlerSimple(
+		BloggerUpdateAuthorProcedure,
+		svc.UpdateAuthor,
+		connect.WithSchema(bloggerMethods.ByName("UpdateAuthor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerDeleteAuthorHandler := connect.NewUnaryHandlerSimple(
+		BloggerDeleteAuthorProcedure,
+		svc.DeleteAuthor,
+		connect.WithSchema(bloggerMethods.ByName("DeleteAuthor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerAddReactionHandler := connect.NewUnaryHandlerSimple(
+		BloggerAddReactionProcedure,
+		svc.AddReaction,
+		connect.WithSchema(bloggerMethods.ByName("AddReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerRemoveReactionHandler := connect.NewUnaryHandlerSimple(
+		BloggerRemoveReactionProcedure,
+		svc.RemoveReaction,
+		connect.WithSchema(bloggerMethods.ByName("RemoveReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerListReactionsHandler := connect.NewUnaryHandlerSimple(
+		BloggerListReactionsProcedure,
+		svc.ListReactions,
+		connect.WithSchema(bloggerMethods.ByName("ListReactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerRecordViewHandler := connect.NewUnaryHandlerSimple(
+		BloggerRecordViewProcedure,
+		svc.RecordView,
+		connect.WithSchema(bloggerMethods.ByName("RecordView")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggerGetBlogViewStatsHandler := connect.NewUnaryHandlerSimple(
+		BloggerGetBlogViewStatsProcedure,
+		svc.GetBlogViewStats,
+		connect.WithSchema(bloggerMethods.ByName("GetBlogViewStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/pb.Blogger/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BloggerGetBlogProcedure:
+			bloggerGetBlogHandler.ServeHTTP(w, r)
+		case BloggerGetBlogsProcedure:
+			bloggerGetBlogsHandler.ServeHTTP(w, r)
+		case BloggerCreateBlogProcedure:
+			bloggerCreateBlogHandler.ServeHTTP(w, r)
+		case BloggerUpdateBlogProcedure:
+			bloggerUpdateBlogHandler.ServeHTTP(w, r)
+		case BloggerDeleteBlogProcedure:
+			bloggerDeleteBlogHandler.ServeHTTP(w, r)
+		case BloggerSearchBlogsProcedure:
+			bloggerSearchBlogsHandler.ServeHTTP(w, r)
+		case BloggerBatchGetBlogsProcedure:
+			bloggerBatchGetBlogsHandler.ServeHTTP(w, r)
+		case BloggerBatchCreateBlogsProcedure:
+			bloggerBatchCreateBlogsHandler.ServeHTTP(w, r)
+		case BloggerBatchDeleteBlogsProcedure:
+			bloggerBatchDeleteBlogsHandler.ServeHTTP(w, r)
+		case BloggerWatchBlogsProcedure:
+			bloggerWatchBlogsHandler.ServeHTTP(w, r)
+		case BloggerImportBlogsProcedure:
+			bloggerImportBlogsHandler.ServeHTTP(w, r)
+		case BloggerExportBlogsProcedure:
+			bloggerExportBlogsHandler.ServeHTTP(w, r)
+		case BloggerUndeleteBlogProcedure:
+			bloggerUndeleteBlogHandler.ServeHTTP(w, r)
+		case BloggerListDeletedBlogsProcedure:
+			bloggerListDeletedBlogsHandler.ServeHTTP(w, r)
+		case BloggerListBlogRevisionsProcedure:
+			bloggerListBlogRevisionsHandler.ServeHTTP(w, r)
+		case BloggerGetBlogRevisionProcedure:
+			bloggerGetBlogRevisionHandler.ServeHTTP(w, r)
+		case BloggerRestoreBlogRevisionProcedure:
+			bloggerRestoreBlogRevisionHandler.ServeHTTP(w, r)
+		case BloggerDiffBlogRevisionsProcedure:
+			bloggerDiffBlogRevisionsHandler.ServeHTTP(w, r)
+		case BloggerPublishBlogProcedure:
+			bloggerPublishBlogHandler.ServeHTTP(w, r)
+		case BloggerUnpublishBlogProcedure:
+			bloggerUnpublishBlogHandler.ServeHTTP(w, r)
+		case BloggerListTagsProcedure:
+			bloggerListTagsHandler.ServeHTTP(w, r)
+		case BloggerRenameTagProcedure:
+			bloggerRenameTagHandler.ServeHTTP(w, r)
+		case BloggerMergeTagsProcedure:
+			bloggerMergeTagsHandler.ServeHTTP(w, r)
+		case BloggerCreateAuthorProcedure:
+			bloggerCreateAuthorHandler.ServeHTTP(w, r)
+		case BloggerGetAuthorProcedure:
+			bloggerGetAuthorHandler.ServeHTTP(w, r)
+		case BloggerListAuthorsProcedure:
+			bloggerListAuthorsHandler.ServeHTTP(w, r)
+		case BloggerUpdateAuthorProcedure:
+			bloggerUpdateAuthorHandler.ServeHTTP(w, r)
+		case BloggerDeleteAuthorProcedure:
+			bloggerDeleteAuthorHandler.ServeHTTP(w, r)
+		case BloggerAddReactionProcedure:
+			bloggerAddReactionHandler.ServeHTTP(w, r)
+		case BloggerRemoveReactionProcedure:
+			bloggerRemoveReactionHandler.ServeHTTP(w, r)
+		case BloggerListReactionsProcedure:
+			bloggerListReactionsHandler.ServeHTTP(w, r)
+		case BloggerRecordViewProcedure:
+			bloggerRecordViewHandler.ServeHTTP(w, r)
+		case BloggerGetBlogViewStatsProcedure:
+			bloggerGetBlogViewStatsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBloggerHandler returns CodeUnimplemented from all methods.
+type UnimplementedBloggerHandler struct{}
+
+func (UnimplementedBloggerHandler) GetBlog(context.Context, *pb.GetBlogRequest) (*pb.GetBlogResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.GetBlog is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) GetBlogs(context.Context, *pb.GetBlogsRequest) (*pb.GetBlogsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.GetBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) CreateBlog(context.Context, *pb.CreateBlogRequest) (*pb.CreateBlogResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.CreateBlog is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) UpdateBlog(context.Context, *pb.UpdateBlogRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.UpdateBlog is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) DeleteBlog(context.Context, *pb.DeleteBlogRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.DeleteBlog is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) SearchBlogs(context.Context, *pb.SearchBlogsRequest) (*pb.SearchBlogsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.SearchBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) BatchGetBlogs(context.Context, *pb.BatchGetBlogsRequest) (*pb.BatchGetBlogsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.BatchGetBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) BatchCreateBlogs(context.Context, *pb.BatchCreateBlogsRequest) (*pb.BatchCreateBlogsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.BatchCreateBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) BatchDeleteBlogs(context.Context, *pb.BatchDeleteBlogsRequest) (*pb.BatchDeleteBlogsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.BatchDeleteBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) WatchBlogs(context.Context, *pb.WatchBlogsRequest, *connect.ServerStream[pb.BlogChange]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.WatchBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) ImportBlogs(context.Context, *connect.ClientStream[pb.ImportBlogsRequest]) (*pb.ImportBlogsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.ImportBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) ExportBlogs(context.Context, *pb.ExportBlogsRequest, *connect.ServerStream[pb.Blog]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.ExportBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) UndeleteBlog(context.Context, *pb.UndeleteBlogRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.UndeleteBlog is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) ListDeletedBlogs(context.Context, *pb.ListDeletedBlogsRequest) (*pb.ListDeletedBlogsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.ListDeletedBlogs is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) ListBlogRevisions(context.Context, *pb.ListBlogRevisionsRequest) (*pb.ListBlogRevisionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.ListBlogRevisions is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) GetBlogRevision(context.Context, *pb.GetBlogRevisionRequest) (*pb.BlogRevision, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.GetBlogRevision is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) RestoreBlogRevision(context.Context, *pb.RestoreBlogRevisionRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.RestoreBlogRevision is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) DiffBlogRevisions(context.Context, *pb.DiffBlogRevisionsRequest) (*pb.DiffBlogRevisionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.DiffBlogRevisions is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) PublishBlog(context.Context, *pb.PublishBlogRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.PublishBlog is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) UnpublishBlog(context.Context, *pb.UnpublishBlogRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.UnpublishBlog is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) ListTags(context.Context, *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.ListTags is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) RenameTag(context.Context, *pb.RenameTagRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.RenameTag is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) MergeTags(context.Context, *pb.MergeTagsRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.MergeTags is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) CreateAuthor(context.Context, *pb.CreateAuthorRequest) (*pb.CreateAuthorResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.CreateAuthor is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) GetAuthor(context.Context, *pb.GetAuthorRequest) (*pb.GetAuthorResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.GetAuthor is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) ListAuthors(context.Context, *pb.ListAuthorsRequest) (*pb.ListAuthorsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.ListAuthors is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) UpdateAuthor(context.Context, *pb.UpdateAuthorRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.UpdateAuthor is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) DeleteAuthor(context.Context, *pb.DeleteAuthorRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.DeleteAuthor is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) AddReaction(context.Context, *pb.AddReactionRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.AddReaction is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) RemoveReaction(context.Context, *pb.RemoveReactionRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.RemoveReaction is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) ListReactions(context.Context, *pb.ListReactionsRequest) (*pb.ListReactionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.ListReactions is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) RecordView(context.Context, *pb.RecordViewRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.RecordView is not implemented"))
+}
+
+func (UnimplementedBloggerHandler) GetBlogViewStats(context.Context, *pb.GetBlogViewStatsRequest) (*pb.GetBlogViewStatsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Blogger.GetBlogViewStats is not implemented"))
+}
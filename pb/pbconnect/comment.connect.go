@@ -0,0 +1,210 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: comment.proto
+
+package pbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	pb "github.com/susana-garcia/go-crud/pb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CommentsName is the fully-qualified name of the Comments service.
+	CommentsName = "pb.Comments"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CommentsCreateCommentProcedure is the fully-qualified name of the Comments's CreateComment RPC.
+	CommentsCreateCommentProcedure = "/pb.Comments/CreateComment"
+	// CommentsGetCommentProcedure is the fully-qualified name of the Comments's GetComment RPC.
+	CommentsGetCommentProcedure = "/pb.Comments/GetComment"
+	// CommentsListCommentsProcedure is the fully-qualified name of the Comments's ListComments RPC.
+	CommentsListCommentsProcedure = "/pb.Comments/ListComments"
+	// CommentsModerateCommentProcedure is the fully-qualified name of the Comments's ModerateComment
+	// RPC.
+	CommentsModerateCommentProcedure = "/pb.Comments/ModerateComment"
+)
+
+// CommentsClient is a client for the pb.Comments service.
+type CommentsClient interface {
+	CreateComment(context.Context, *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error)
+	GetComment(context.Context, *pb.GetCommentRequest) (*pb.Comment, error)
+	ListComments(context.Context, *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error)
+	ModerateComment(context.Context, *pb.ModerateCommentRequest) (*emptypb.Empty, error)
+}
+
+// NewCommentsClient constructs a client for the pb.Comments service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCommentsClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CommentsClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	commentsMethods := pb.File_comment_proto.Services().ByName("Comments").Methods()
+	return &commentsClient{
+		createComment: connect.NewClient[pb.CreateCommentRequest, pb.CreateCommentResponse](
+			httpClient,
+			baseURL+CommentsCreateCommentProcedure,
+			connect.WithSchema(commentsMethods.ByName("CreateComment")),
+			connect.WithClientOptions(opts...),
+		),
+		getComment: connect.NewClient[pb.GetCommentRequest, pb.Comment](
+			httpClient,
+			baseURL+CommentsGetCommentProcedure,
+			connect.WithSchema(commentsMethods.ByName("GetComment")),
+			connect.WithClientOptions(opts...),
+		),
+		listComments: connect.NewClient[pb.ListCommentsRequest, pb.ListCommentsResponse](
+			httpClient,
+			baseURL+CommentsListCommentsProcedure,
+			connect.WithSchema(commentsMethods.ByName("ListComments")),
+			connect.WithClientOptions(opts...),
+		),
+		moderateComment: connect.NewClient[pb.ModerateCommentRequest, emptypb.Empty](
+			httpClient,
+			baseURL+CommentsModerateCommentProcedure,
+			connect.WithSchema(commentsMethods.ByName("ModerateComment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// commentsClient implements CommentsClient.
+type commentsClient struct {
+	createComment   *connect.Client[pb.CreateCommentRequest, pb.CreateCommentResponse]
+	getComment      *connect.Client[pb.GetCommentRequest, pb.Comment]
+	listComments    *connect.Client[pb.ListCommentsRequest, pb.ListCommentsResponse]
+	moderateComment *connect.Client[pb.ModerateCommentRequest, emptypb.Empty]
+}
+
+// CreateComment calls pb.Comments.CreateComment.
+func (c *commentsClient) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
+	response, err := c.createComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetComment calls pb.Comments.GetComment.
+func (c *commentsClient) GetComment(ctx context.Context, req *pb.GetCommentRequest) (*pb.Comment, error) {
+	response, err := c.getComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListComments calls pb.Comments.ListComments.
+func (c *commentsClient) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	response, err := c.listComments.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ModerateComment calls pb.Comments.ModerateComment.
+func (c *commentsClient) ModerateComment(ctx context.Context, req *pb.ModerateCommentRequest) (*emptypb.Empty, error) {
+	response, err := c.moderateComment.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CommentsHandler is an implementation of the pb.Comments service.
+type CommentsHandler interface {
+	CreateComment(context.Context, *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error)
+	GetComment(context.Context, *pb.GetCommentRequest) (*pb.Comment, error)
+	ListComments(context.Context, *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error)
+	ModerateComment(context.Context, *pb.ModerateCommentRequest) (*emptypb.Empty, error)
+}
+
+// NewCommentsHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCommentsHandler(svc CommentsHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	commentsMethods := pb.File_comment_proto.Services().ByName("Comments").Methods()
+	commentsCreateCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentsCreateCommentProcedure,
+		svc.CreateComment,
+		connect.WithSchema(commentsMethods.ByName("CreateComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentsGetCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentsGetCommentProcedure,
+		svc.GetComment,
+		connect.WithSchema(commentsMethods.ByName("GetComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentsListCommentsHandler := connect.NewUnaryHandlerSimple(
+		CommentsListCommentsProcedure,
+		svc.ListComments,
+		connect.WithSchema(commentsMethods.ByName("ListComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentsModerateCommentHandler := connect.NewUnaryHandlerSimple(
+		CommentsModerateCommentProcedure,
+		svc.ModerateComment,
+		connect.WithSchema(commentsMethods.ByName("ModerateComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/pb.Comments/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CommentsCreateCommentProcedure:
+			commentsCreateCommentHandler.ServeHTTP(w, r)
+		case CommentsGetCommentProcedure:
+			commentsGetCommentHandler.ServeHTTP(w, r)
+		case CommentsListCommentsProcedure:
+			commentsListCommentsHandler.ServeHTTP(w, r)
+		case CommentsModerateCommentProcedure:
+			commentsModerateCommentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCommentsHandler returns CodeUnimplemented from all methods.
+type UnimplementedCommentsHandler struct{}
+
+func (UnimplementedCommentsHandler) CreateComment(context.Context, *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Comments.CreateComment is not implemented"))
+}
+
+func (UnimplementedCommentsHandler) GetComment(context.Context, *pb.GetCommentRequest) (*pb.Comment, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Comments.GetComment is not implemented"))
+}
+
+func (UnimplementedCommentsHandler) ListComments(context.Context, *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Comments.ListComments is not implemented"))
+}
+
+func (UnimplementedCommentsHandler) ModerateComment(context.Context, *pb.ModerateCommentRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.Comments.ModerateComment is not implemented"))
+}
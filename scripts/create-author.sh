@@ -0,0 +1,5 @@
+# scripts/create-author.sh ada "Ada Lovelace"
+
+grpcurl -plaintext \
+  -d '{"handle": "'"$1"'", "name": "'"$2"'"}' \
+  localhost:8080 pb.Blogger/CreateAuthor
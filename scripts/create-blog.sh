@@ -1,5 +1,13 @@
 # scripts/create-blog.sh "new blog"
+# scripts/create-blog.sh "new blog" "some-idempotency-key"
 
-grpcurl -plaintext \
-  -d '{"title": "'"$1"'", "body": "some body"}' \
-  localhost:8080 pb.Blogger/CreateBlog
+if [ "$#" -eq 2 ]; then
+  grpcurl -plaintext \
+    -H "idempotency-key: $2" \
+    -d '{"title": "'"$1"'", "body": "some body"}' \
+    localhost:8080 pb.Blogger/CreateBlog
+else
+  grpcurl -plaintext \
+    -d '{"title": "'"$1"'", "body": "some body"}' \
+    localhost:8080 pb.Blogger/CreateBlog
+fi
@@ -0,0 +1,6 @@
+# scripts/create-comment.sh 4 "nice post"
+# scripts/create-comment.sh 4 "thanks" 7
+
+grpcurl -plaintext \
+  -d '{"blog_id": '"$1"', "parent_id": '"${3:-0}"', "author_name": "reader", "body": "'"$2"'"}' \
+  localhost:8080 pb.Comments/CreateComment
@@ -0,0 +1,5 @@
+# scripts/delete-author.sh 1
+
+grpcurl -plaintext \
+  -d '{"id": '"$1"'}' \
+  localhost:8080 pb.Blogger/DeleteAuthor
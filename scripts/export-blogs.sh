@@ -0,0 +1,6 @@
+# scripts/export-blogs.sh
+# scripts/export-blogs.sh "id > 10"
+
+grpcurl -plaintext \
+  -d '{"filter": "'"$1"'"}' \
+  localhost:8080 pb.Blogger/ExportBlogs
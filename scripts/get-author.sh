@@ -0,0 +1,13 @@
+# scripts/get-author.sh ada
+# scripts/get-author.sh 1
+
+re='^[0-9]+$' # number -> for id
+if ! [[ $1 =~ $re ]] ; then
+  grpcurl -plaintext \
+    -d '{"handle": "'"$1"'"}' \
+    localhost:8080 pb.Blogger/GetAuthor
+else
+  grpcurl -plaintext \
+    -d '{"id": '"$1"'}' \
+    localhost:8080 pb.Blogger/GetAuthor
+fi
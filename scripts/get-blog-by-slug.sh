@@ -0,0 +1,5 @@
+# scripts/get-blog-by-slug.sh cafe-deja-vu
+
+grpcurl -plaintext \
+  -d '{"slug": "'"$1"'"}' \
+  localhost:8080 pb.Blogger/GetBlog
@@ -0,0 +1,6 @@
+# scripts/get-blog-view-stats.sh 1
+# scripts/get-blog-view-stats.sh 1 2024-01-01 2024-01-31
+
+grpcurl -plaintext \
+  -d '{"blog_id": '"$1"', "from": "'"$2"'", "to": "'"$3"'"}' \
+  localhost:8080 pb.Blogger/GetBlogViewStats
@@ -0,0 +1,5 @@
+# scripts/get-blogs-by-tag.sh go
+
+grpcurl -plaintext \
+  -d '{"limit": 10, "tag": "'"$1"'"}' \
+  localhost:8080 pb.Blogger/GetBlogs
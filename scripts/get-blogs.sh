@@ -1,5 +1,5 @@
 
-# scripts/get-blogs.sh 10 2 "Id asc"
+# scripts/get-blogs.sh 10 2 "created_at desc, title asc"
 # scripts/get-blogs.sh 10 2
 # scripts/get-blogs.sh 10
 
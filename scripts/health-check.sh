@@ -0,0 +1,11 @@
+# scripts/health-check.sh
+# scripts/health-check.sh pb.Blogger
+# scripts/health-check.sh pb.Blogger watch
+
+method=Check
+if [ "$2" = "watch" ] ; then
+  method=Watch
+fi
+grpcurl -plaintext \
+  -d '{"service": "'"$1"'"}' \
+  localhost:8080 grpc.health.v1.Health/$method
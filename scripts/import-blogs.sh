@@ -0,0 +1,7 @@
+# scripts/import-blogs.sh dump.json
+# dump.json holds one ImportBlogsRequest per line, e.g.
+# {"blog": {"source_id": "1", "title": "old blog", "body": "some body", "created_at": "2020-01-02T03:04:05Z"}}
+
+grpcurl -plaintext \
+  -d @ \
+  localhost:8080 pb.Blogger/ImportBlogs < "$1"
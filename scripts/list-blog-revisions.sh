@@ -0,0 +1,5 @@
+# scripts/list-blog-revisions.sh 4
+
+grpcurl -plaintext \
+  -d '{"blog_id": '"$1"', "limit": 10, "page": 1}' \
+  localhost:8080 pb.Blogger/ListBlogRevisions
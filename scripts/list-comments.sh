@@ -0,0 +1,6 @@
+# scripts/list-comments.sh 4
+# scripts/list-comments.sh 4 MODE_FLAT
+
+grpcurl -plaintext \
+  -d '{"blog_id": '"$1"', "mode": "'"${2:-MODE_TREE}"'", "limit": 10, "page": 1}' \
+  localhost:8080 pb.Comments/ListComments
@@ -0,0 +1,5 @@
+# scripts/list-deleted-blogs.sh
+
+grpcurl -plaintext \
+  -d '{"limit": 10, "page": 1}' \
+  localhost:8080 pb.Blogger/ListDeletedBlogs
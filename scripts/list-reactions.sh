@@ -0,0 +1,6 @@
+# scripts/list-reactions.sh 1
+# scripts/list-reactions.sh 1 REACTION_TYPE_LIKE
+
+grpcurl -plaintext \
+  -d '{"blog_id": '"$1"', "type": "'"${2:-REACTION_TYPE_UNSPECIFIED}"'", "limit": 10, "page": 1}' \
+  localhost:8080 pb.Blogger/ListReactions
@@ -0,0 +1,9 @@
+# scripts/merge-tags.sh go golang go-lang
+
+target=$1
+shift
+sources=$(printf '"%s",' "$@")
+
+grpcurl -plaintext \
+  -d '{"sources": ['"${sources%,}"'], "target": "'"$target"'"}' \
+  localhost:8080 pb.Blogger/MergeTags
@@ -0,0 +1,5 @@
+# scripts/moderate-comment.sh 7 COMMENT_STATUS_APPROVED
+
+grpcurl -plaintext \
+  -d '{"id": '"$1"', "status": "'"$2"'"}' \
+  localhost:8080 pb.Comments/ModerateComment
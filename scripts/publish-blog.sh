@@ -0,0 +1,12 @@
+# scripts/publish-blog.sh 4
+# scripts/publish-blog.sh 4 "2026-12-01T09:00:00Z"
+
+if [ "$#" -eq 2 ]; then
+  grpcurl -plaintext \
+    -d '{"id": '"$1"', "publish_at": "'"$2"'"}' \
+    localhost:8080 pb.Blogger/PublishBlog
+else
+  grpcurl -plaintext \
+    -d '{"id": '"$1"'}' \
+    localhost:8080 pb.Blogger/PublishBlog
+fi
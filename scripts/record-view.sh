@@ -0,0 +1,5 @@
+# scripts/record-view.sh 1
+
+grpcurl -plaintext \
+  -d '{"blog_id": '"$1"'}' \
+  localhost:8080 pb.Blogger/RecordView
@@ -0,0 +1,5 @@
+# scripts/remove-reaction.sh 1 ada REACTION_TYPE_LIKE
+
+grpcurl -plaintext \
+  -d '{"blog_id": '"$1"', "user_id": "'"$2"'", "type": "'"$3"'"}' \
+  localhost:8080 pb.Blogger/RemoveReaction
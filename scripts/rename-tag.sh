@@ -0,0 +1,5 @@
+# scripts/rename-tag.sh golang go
+
+grpcurl -plaintext \
+  -d '{"name": "'"$1"'", "new_name": "'"$2"'"}' \
+  localhost:8080 pb.Blogger/RenameTag
@@ -0,0 +1,6 @@
+# scripts/restore-blog-revision.sh 4 2 "editor name"
+
+grpcurl -plaintext \
+  -H "editor: $3" \
+  -d '{"blog_id": '"$1"', "revision": '"$2"'}' \
+  localhost:8080 pb.Blogger/RestoreBlogRevision
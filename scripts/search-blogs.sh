@@ -0,0 +1,5 @@
+# scripts/search-blogs.sh "go -rust"
+
+grpcurl -plaintext \
+  -d '{"query": "'"$1"'"}' \
+  localhost:8080 pb.Blogger/SearchBlogs
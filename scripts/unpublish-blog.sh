@@ -0,0 +1,5 @@
+# scripts/unpublish-blog.sh 4
+
+grpcurl -plaintext \
+  -d '{"id": '"$1"'}' \
+  localhost:8080 pb.Blogger/UnpublishBlog
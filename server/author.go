@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateAuthor(ctx context.Context, req *pb.CreateAuthorRequest) (*pb.CreateAuthorResponse, error) {
+	id, err := s.service.CreateAuthor(ctx, service.Author{
+		Handle:    req.GetHandle(),
+		Name:      req.GetName(),
+		Bio:       req.GetBio(),
+		AvatarURL: req.GetAvatarUrl(),
+	})
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &pb.CreateAuthorResponse{
+		Id: uint32(id),
+	}, nil
+}
+
+func (s *Server) GetAuthor(ctx context.Context, req *pb.GetAuthorRequest) (*pb.GetAuthorResponse, error) {
+	author, err := s.service.GetAuthorByIDOrHandle(ctx, uint(req.GetId()), req.GetHandle())
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &pb.GetAuthorResponse{
+		Item: toPBAuthor(*author),
+	}, nil
+}
+
+func (s *Server) ListAuthors(ctx context.Context, req *pb.ListAuthorsRequest) (*pb.ListAuthorsResponse, error) {
+	pagination := service.Pagination{
+		Limit: int(req.GetLimit()),
+		Page:  int(req.GetPage()),
+	}
+	sRes, err := s.service.GetAuthors(ctx, &pagination)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.ListAuthorsResponse
+	for _, author := range sRes.Items.([]service.Author) {
+		res.Items = append(res.Items, toPBAuthor(author))
+	}
+	res.Limit = int32(sRes.Limit)
+	res.Page = int32(sRes.Page)
+	res.TotalItems = sRes.TotalItems
+	res.TotalPages = int32(sRes.TotalPages)
+	return &res, nil
+}
+
+func (s *Server) UpdateAuthor(ctx context.Context, req *pb.UpdateAuthorRequest) (*emptypb.Empty, error) {
+	// only the fields present in the request are updated
+	var fields []string
+	if req.Handle != nil {
+		fields = append(fields, "handle")
+	}
+	if req.Name != nil {
+		fields = append(fields, "name")
+	}
+	if req.Bio != nil {
+		fields = append(fields, "bio")
+	}
+	if req.AvatarUrl != nil {
+		fields = append(fields, "avatar_url")
+	}
+	err := s.service.UpdateAuthor(ctx, service.Author{
+		ID:        uint(req.GetId()),
+		Handle:    req.GetHandle(),
+		Name:      req.GetName(),
+		Bio:       req.GetBio(),
+		AvatarURL: req.GetAvatarUrl(),
+	}, fields)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) DeleteAuthor(ctx context.Context, req *pb.DeleteAuthorRequest) (*emptypb.Empty, error) {
+	ctx = editorContext(ctx)
+	err := s.service.DeleteAuthor(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toPBAuthor(author service.Author) *pb.Author {
+	return &pb.Author{
+		Id:        uint32(author.ID),
+		Handle:    author.Handle,
+		Name:      author.Name,
+		Bio:       author.Bio,
+		AvatarUrl: author.AvatarURL,
+		CreatedAt: timestamppb.New(author.CreatedAt),
+		UpdatedAt: timestamppb.New(author.UpdatedAt),
+	}
+}
+
+// authorID returns the author id of a blog, nil for blogs without an author
+func authorID(id uint32) *uint {
+	if id == 0 {
+		return nil
+	}
+	authorID := uint(id)
+	return &authorID
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestAuthors(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating an author
+	ada, err := tEnv.Client.CreateAuthor(ctx, &pb.CreateAuthorRequest{Handle: "ada", Name: "Ada Lovelace", AvatarUrl: "https://example.com/ada.png"})
+	assert.NoError(t, err)
+
+	createTests := []struct {
+		name      string
+		request   *pb.CreateAuthorRequest
+		wantError *status.Status
+	}{
+		{
+			name:    "should create author successfully",
+			request: &pb.CreateAuthorRequest{Handle: "grace", Name: "Grace Hopper", Bio: "COBOL"},
+		},
+		{
+			name:      "should fail when handle is taken",
+			request:   &pb.CreateAuthorRequest{Handle: "ada", Name: "Ada"},
+			wantError: status.New(codes.AlreadyExists, `handle "ada" is already taken`),
+		},
+		{
+			name:      "should fail when handle is invalid",
+			request:   &pb.CreateAuthorRequest{Handle: "Ada Lovelace", Name: "Ada"},
+			wantError: status.New(codes.InvalidArgument, ""),
+		},
+		{
+			name:      "should fail when avatar url is invalid",
+			request:   &pb.CreateAuthorRequest{Handle: "alan", Name: "Alan", AvatarUrl: "not a url"},
+			wantError: status.New(codes.InvalidArgument, ""),
+		},
+	}
+	for _, tt := range createTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tEnv.Client.CreateAuthor(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+
+	got, err := tEnv.Client.GetAuthor(ctx, &pb.GetAuthorRequest{Value: &pb.GetAuthorRequest_Handle{Handle: "ada"}})
+	assert.NoError(t, err)
+	assert.Equal(t, ada.Id, got.Item.Id)
+	assert.Equal(t, "Ada Lovelace", got.Item.Name)
+	_, err = tEnv.Client.GetAuthor(ctx, &pb.GetAuthorRequest{Value: &pb.GetAuthorRequest_Id{Id: ada.Id + 1000}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = tEnv.Client.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{Id: ada.Id, Bio: proto.String("Analytical Engine"), AvatarUrl: proto.String("")})
+	assert.NoError(t, err)
+	got, err = tEnv.Client.GetAuthor(ctx, &pb.GetAuthorRequest{Value: &pb.GetAuthorRequest_Id{Id: ada.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Analytical Engine", got.Item.Bio)
+	assert.Empty(t, got.Item.AvatarUrl)
+	_, err = tEnv.Client.UpdateAuthor(ctx, &pb.UpdateAuthorRequest{Id: ada.Id, Handle: proto.String("grace")})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	authors, err := tEnv.Client.ListAuthors(ctx, &pb.ListAuthorsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), authors.TotalItems)
+	assert.Equal(t, "ada", authors.Items[0].Handle)
+	assert.Equal(t, "grace", authors.Items[1].Handle)
+
+	// blogs embed a summary of their author
+	blog, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "engines", Body: "body", AuthorId: ada.Id})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "anonymous", Body: "body"})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "unknown", Body: "body", AuthorId: ada.Id + 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	blogs, err := tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{AuthorId: ada.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), blogs.TotalItems)
+	assert.Equal(t, blog.Id, blogs.Items[0].Id)
+	assert.Equal(t, ada.Id, blogs.Items[0].Author.GetId())
+	assert.Equal(t, "ada", blogs.Items[0].Author.GetHandle())
+	blogs, err = tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), blogs.TotalItems)
+	assert.Nil(t, blogs.Items[0].Author)
+
+	// deleting the author keeps its blogs
+	_, err = tEnv.Client.DeleteAuthor(ctx, &pb.DeleteAuthorRequest{Id: ada.Id})
+	assert.NoError(t, err)
+	gotBlog, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: blog.Id}})
+	assert.NoError(t, err)
+	assert.Nil(t, gotBlog.Item.Author)
+	assert.Equal(t, "2", gotBlog.Item.Etag)
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) BatchGetBlogs(ctx context.Context, req *pb.BatchGetBlogsRequest) (*pb.BatchGetBlogsResponse, error) {
+	ids := make([]uint, len(req.GetIds()))
+	for i, id := range req.GetIds() {
+		ids[i] = uint(id)
+	}
+	blogs, err := s.service.GetBlogsByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	found := make(map[uint]service.Blog, len(blogs))
+	for _, blog := range blogs {
+		found[blog.ID] = blog
+	}
+
+	var res pb.BatchGetBlogsResponse
+	for _, id := range ids {
+		blog, ok := found[id]
+		if !ok {
+			res.Results = append(res.Results, &pb.BatchGetBlogResult{
+				Status: status.New(codes.NotFound, "blog not found").Proto(),
+			})
+			continue
+		}
+		res.Results = append(res.Results, &pb.BatchGetBlogResult{
+			Item:   toPBBlog(blog),
+			Status: status.New(codes.OK, "").Proto(),
+		})
+	}
+	return &res, nil
+}
+
+func (s *Server) BatchCreateBlogs(ctx context.Context, req *pb.BatchCreateBlogsRequest) (*pb.BatchCreateBlogsResponse, error) {
+	ctx = editorContext(ctx)
+	// validate every item on its own so that invalid items can be reported
+	// without failing the whole batch
+	results := make([]*pb.BatchCreateBlogResult, len(req.GetRequests()))
+	var blogs []service.Blog
+	var indexes []int
+	for i, item := range req.GetRequests() {
+		if err := protovalidate.Validate(item); err != nil {
+			if req.GetAllOrNothing() {
+				return nil, status.Errorf(codes.InvalidArgument, "validation failed for item %d: %v", i, err)
+			}
+			results[i] = &pb.BatchCreateBlogResult{
+				Status: status.New(codes.InvalidArgument, fmt.Sprintf("validation failed: %v", err)).Proto(),
+			}
+			continue
+		}
+		blogs = append(blogs, service.Blog{
+			Title:    item.GetTitle(),
+			Body:     item.GetBody(),
+			Status:   blogStatuses[item.GetStatus()],
+			Tags:     service.NewTags(item.GetTags()),
+			AuthorID: authorID(item.GetAuthorId()),
+		})
+		indexes = append(indexes, i)
+	}
+
+	if len(blogs) > 0 {
+		sRes, err := s.service.CreateBlogs(ctx, blogs, req.GetAllOrNothing())
+		if err != nil {
+			s.logger.Error("got service error ", "error", err)
+			return nil, err
+		}
+		for i, result := range sRes {
+			results[indexes[i]] = &pb.BatchCreateBlogResult{
+				Id:     uint32(result.ID),
+				Status: status.Convert(result.Err).Proto(),
+			}
+		}
+	}
+	return &pb.BatchCreateBlogsResponse{Results: results}, nil
+}
+
+func (s *Server) BatchDeleteBlogs(ctx context.Context, req *pb.BatchDeleteBlogsRequest) (*pb.BatchDeleteBlogsResponse, error) {
+	ctx = editorContext(ctx)
+	ids := make([]uint, len(req.GetIds()))
+	for i, id := range req.GetIds() {
+		ids[i] = uint(id)
+	}
+	sRes, err := s.service.DeleteBlogs(ctx, ids, req.GetAllOrNothing())
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.BatchDeleteBlogsResponse
+	for _, result := range sRes {
+		res.Results = append(res.Results, &pb.BatchDeleteBlogResult{
+			Status: status.Convert(result.Err).Proto(),
+		})
+	}
+	return &res, nil
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestBatchCreateBlogs(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	requests := []*pb.CreateBlogRequest{
+		{Title: "first title", Body: "first body"},
+		{Title: "", Body: "invalid body"},
+		{Title: "third title", Body: "third body"},
+	}
+
+	tests := []struct {
+		name      string
+		request   *pb.BatchCreateBlogsRequest
+		wantCodes []codes.Code
+		wantError *status.Status
+	}{
+		{
+			name:      "should fail all items when one is invalid and all or nothing",
+			request:   &pb.BatchCreateBlogsRequest{Requests: requests, AllOrNothing: true},
+			wantError: status.New(codes.InvalidArgument, "item 1"),
+		},
+		{
+			name:      "should report invalid items and create the others",
+			request:   &pb.BatchCreateBlogsRequest{Requests: requests},
+			wantCodes: []codes.Code{codes.OK, codes.InvalidArgument, codes.OK},
+		},
+		{
+			name:      "should fail when batch is empty",
+			request:   &pb.BatchCreateBlogsRequest{},
+			wantError: status.New(codes.InvalidArgument, "repeated.min_items"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tEnv.Client.BatchCreateBlogs(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, len(tt.wantCodes), len(resp.Results))
+				for i, result := range resp.Results {
+					assert.Equal(t, int32(tt.wantCodes[i]), result.Status.Code)
+					assert.Equal(t, tt.wantCodes[i] == codes.OK, result.Id > 0)
+				}
+			}
+		})
+	}
+
+	// only the valid items of the second batch were created
+	resp, err := tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), resp.TotalItems)
+}
+
+func TestBatchGetAndDeleteBlogs(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating a new entries
+	created, err := tEnv.Client.BatchCreateBlogs(ctx, &pb.BatchCreateBlogsRequest{
+		Requests: []*pb.CreateBlogRequest{
+			{Title: "first title", Body: "first body"},
+			{Title: "second title", Body: "second body"},
+		},
+		AllOrNothing: true,
+	})
+	assert.NoError(t, err)
+	first, second := created.Results[0].Id, created.Results[1].Id
+	missing := second + 1000
+
+	got, err := tEnv.Client.BatchGetBlogs(ctx, &pb.BatchGetBlogsRequest{Ids: []uint32{second, missing, first}})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(got.Results))
+	assert.Equal(t, second, got.Results[0].Item.Id)
+	assert.Equal(t, int32(codes.NotFound), got.Results[1].Status.Code)
+	assert.Equal(t, first, got.Results[2].Item.Id)
+
+	// a missing blog rolls back the whole batch when all or nothing
+	_, err = tEnv.Client.BatchDeleteBlogs(ctx, &pb.BatchDeleteBlogsRequest{Ids: []uint32{first, missing}, AllOrNothing: true})
+	s, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, s.Code())
+
+	deleted, err := tEnv.Client.BatchDeleteBlogs(ctx, &pb.BatchDeleteBlogsRequest{Ids: []uint32{first, missing, second}})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(codes.OK), deleted.Results[0].Status.Code)
+	assert.Equal(t, int32(codes.NotFound), deleted.Results[1].Status.Code)
+	assert.Equal(t, int32(codes.OK), deleted.Results[2].Status.Code)
+}
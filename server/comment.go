@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	commentStatuses = map[pb.CommentStatus]service.CommentStatus{
+		pb.CommentStatus_COMMENT_STATUS_PENDING:  service.CommentPending,
+		pb.CommentStatus_COMMENT_STATUS_APPROVED: service.CommentApproved,
+		pb.CommentStatus_COMMENT_STATUS_REJECTED: service.CommentRejected,
+		pb.CommentStatus_COMMENT_STATUS_SPAM:     service.CommentSpam,
+	}
+	pbCommentStatuses = map[service.CommentStatus]pb.CommentStatus{
+		service.CommentPending:  pb.CommentStatus_COMMENT_STATUS_PENDING,
+		service.CommentApproved: pb.CommentStatus_COMMENT_STATUS_APPROVED,
+		service.CommentRejected: pb.CommentStatus_COMMENT_STATUS_REJECTED,
+		service.CommentSpam:     pb.CommentStatus_COMMENT_STATUS_SPAM,
+	}
+)
+
+// CommentServer serves the Comments service
+type CommentServer struct {
+	service service.Commenter
+	logger  *slog.Logger
+	pb.UnimplementedCommentsServer
+}
+
+func NewCommentServer(service service.Commenter, logger *slog.Logger) *CommentServer {
+	return &CommentServer{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (s *CommentServer) Register(server grpc.ServiceRegistrar) {
+	pb.RegisterCommentsServer(server, s)
+}
+
+func (s *CommentServer) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
+	comment := service.Comment{
+		BlogID:     uint(req.GetBlogId()),
+		AuthorName: req.GetAuthorName(),
+		Body:       req.GetBody(),
+	}
+	if req.GetParentId() > 0 {
+		parentID := uint(req.GetParentId())
+		comment.ParentID = &parentID
+	}
+	id, err := s.service.CreateComment(ctx, comment)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &pb.CreateCommentResponse{
+		Id: uint32(id),
+	}, nil
+}
+
+func (s *CommentServer) GetComment(ctx context.Context, req *pb.GetCommentRequest) (*pb.Comment, error) {
+	comment, err := s.service.GetComment(ctx, uint(req.GetId()))
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return toPBComment(*comment), nil
+}
+
+func (s *CommentServer) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	mode := service.CommentsTree
+	if req.GetMode() == pb.ListCommentsRequest_MODE_FLAT {
+		mode = service.CommentsFlat
+	}
+	var statuses []service.CommentStatus
+	for _, st := range req.GetStatuses() {
+		statuses = append(statuses, commentStatuses[st])
+	}
+	pagination := service.Pagination{
+		Limit: int(req.GetLimit()),
+		Page:  int(req.GetPage()),
+	}
+	sRes, err := s.service.GetComments(ctx, uint(req.GetBlogId()), uint(req.GetParentId()), mode, statuses, &pagination)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.ListCommentsResponse
+	for _, comment := range sRes.Items.([]service.Comment) {
+		res.Items = append(res.Items, toPBComment(comment))
+	}
+	res.Limit = int32(sRes.Limit)
+	res.Page = int32(sRes.Page)
+	res.TotalItems = sRes.TotalItems
+	res.TotalPages = int32(sRes.TotalPages)
+	return &res, nil
+}
+
+func (s *CommentServer) ModerateComment(ctx context.Context, req *pb.ModerateCommentRequest) (*emptypb.Empty, error) {
+	err := s.service.ModerateComment(ctx, uint(req.GetId()), commentStatuses[req.GetStatus()])
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toPBComment(comment service.Comment) *pb.Comment {
+	res := &pb.Comment{
+		Id:         uint32(comment.ID),
+		BlogId:     uint32(comment.BlogID),
+		AuthorName: comment.AuthorName,
+		Body:       comment.Body,
+		Status:     pbCommentStatuses[comment.Status],
+		CreatedAt:  timestamppb.New(comment.CreatedAt),
+		UpdatedAt:  timestamppb.New(comment.UpdatedAt),
+		Depth:      uint32(comment.Depth),
+	}
+	if comment.ParentID != nil {
+		res.ParentId = uint32(*comment.ParentID)
+	}
+	for _, reply := range comment.Replies {
+		res.Replies = append(res.Replies, toPBComment(reply))
+	}
+	return res
+}
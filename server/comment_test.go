@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestComments(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		New(bService, logger).Register(reg)
+		NewCommentServer(bService, logger).Register(reg)
+	})
+	defer tEnv.Cancel()
+	client := tEnv.CommentsClient
+
+	// prepare test by creating a blog, a draft and a thread of approved comments
+	blog, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "blog", Body: "body"})
+	assert.NoError(t, err)
+	other, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "other", Body: "body"})
+	assert.NoError(t, err)
+	draft, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "draft", Body: "body", Status: pb.BlogStatus_BLOG_STATUS_DRAFT})
+	assert.NoError(t, err)
+	createApproved := func(parentID uint32, body string) uint32 {
+		res, err := client.CreateComment(ctx, &pb.CreateCommentRequest{BlogId: blog.Id, ParentId: parentID, AuthorName: "ada", Body: body})
+		assert.NoError(t, err)
+		_, err = client.ModerateComment(ctx, &pb.ModerateCommentRequest{Id: res.Id, Status: pb.CommentStatus_COMMENT_STATUS_APPROVED})
+		assert.NoError(t, err)
+		return res.Id
+	}
+	first := createApproved(0, "first")
+	reply := createApproved(first, "reply")
+	createApproved(reply, "reply to reply")
+	second := createApproved(0, "second")
+	spam, err := client.CreateComment(ctx, &pb.CreateCommentRequest{BlogId: blog.Id, ParentId: second, AuthorName: "bot", Body: "spam"})
+	assert.NoError(t, err)
+	_, err = client.ModerateComment(ctx, &pb.ModerateCommentRequest{Id: spam.Id, Status: pb.CommentStatus_COMMENT_STATUS_SPAM})
+	assert.NoError(t, err)
+	pending, err := client.CreateComment(ctx, &pb.CreateCommentRequest{BlogId: blog.Id, AuthorName: "grace", Body: "pending"})
+	assert.NoError(t, err)
+
+	createTests := []struct {
+		name      string
+		request   *pb.CreateCommentRequest
+		wantError *status.Status
+	}{
+		{
+			name:      "should fail when blog does not exist",
+			request:   &pb.CreateCommentRequest{BlogId: draft.Id + 1000, AuthorName: "ada", Body: "body"},
+			wantError: status.New(codes.NotFound, "blog not found"),
+		},
+		{
+			name:      "should fail when blog is not published",
+			request:   &pb.CreateCommentRequest{BlogId: draft.Id, AuthorName: "ada", Body: "body"},
+			wantError: status.New(codes.FailedPrecondition, "comments can only be added to published blogs"),
+		},
+		{
+			name:      "should fail when replying to a comment of another blog",
+			request:   &pb.CreateCommentRequest{BlogId: other.Id, ParentId: first, AuthorName: "ada", Body: "body"},
+			wantError: status.New(codes.InvalidArgument, "parent comment not found in blog"),
+		},
+		{
+			name:      "should fail when replying to a comment that is not approved",
+			request:   &pb.CreateCommentRequest{BlogId: blog.Id, ParentId: pending.Id, AuthorName: "ada", Body: "body"},
+			wantError: status.New(codes.FailedPrecondition, "only approved comments can be replied to"),
+		},
+		{
+			name:      "should fail when parent does not exist",
+			request:   &pb.CreateCommentRequest{BlogId: blog.Id, ParentId: pending.Id + 1000, AuthorName: "ada", Body: "body"},
+			wantError: status.New(codes.InvalidArgument, "parent comment not found in blog"),
+		},
+	}
+	for _, tt := range createTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.CreateComment(ctx, tt.request)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantError.Code(), s.Code())
+			assert.Equal(t, tt.wantError.Message(), s.Message())
+		})
+	}
+
+	got, err := client.GetComment(ctx, &pb.GetCommentRequest{Id: pending.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.CommentStatus_COMMENT_STATUS_PENDING, got.Status)
+
+	// the tree pages the top level comments with their approved replies nested
+	tree, err := client.ListComments(ctx, &pb.ListCommentsRequest{BlogId: blog.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), tree.TotalItems)
+	assert.Equal(t, first, tree.Items[0].Id)
+	assert.Equal(t, reply, tree.Items[0].Replies[0].Id)
+	assert.Equal(t, "reply to reply", tree.Items[0].Replies[0].Replies[0].Body)
+	assert.Equal(t, uint32(2), tree.Items[0].Replies[0].Replies[0].Depth)
+	assert.Equal(t, second, tree.Items[1].Id)
+	assert.Empty(t, tree.Items[1].Replies)
+
+	tree, err = client.ListComments(ctx, &pb.ListCommentsRequest{BlogId: blog.Id, Limit: 1, Page: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), tree.TotalPages)
+	assert.Equal(t, second, tree.Items[0].Id)
+
+	// the flat list pages all the comments oldest first
+	flat, err := client.ListComments(ctx, &pb.ListCommentsRequest{BlogId: blog.Id, Mode: pb.ListCommentsRequest_MODE_FLAT})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), flat.TotalItems)
+	var bodies []string
+	for _, comment := range flat.Items {
+		bodies = append(bodies, comment.Body)
+		assert.Empty(t, comment.Replies)
+	}
+	assert.Equal(t, []string{"first", "reply", "reply to reply", "second"}, bodies)
+	assert.Equal(t, first, flat.Items[1].ParentId)
+
+	flat, err = client.ListComments(ctx, &pb.ListCommentsRequest{
+		BlogId:   blog.Id,
+		ParentId: second,
+		Mode:     pb.ListCommentsRequest_MODE_FLAT,
+		Statuses: []pb.CommentStatus{pb.CommentStatus_COMMENT_STATUS_SPAM},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), flat.TotalItems)
+	assert.Equal(t, spam.Id, flat.Items[0].Id)
+
+	// comments are gone with their blog
+	_, err = tEnv.Client.DeleteBlog(ctx, &pb.DeleteBlogRequest{Id: blog.Id})
+	assert.NoError(t, err)
+	_, err = client.ListComments(ctx, &pb.ListCommentsRequest{BlogId: blog.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.GetComment(ctx, &pb.GetCommentRequest{Id: first})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.ModerateComment(ctx, &pb.ModerateCommentRequest{Id: pending.Id, Status: pb.CommentStatus_COMMENT_STATUS_APPROVED})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	var moderated service.Comment
+	assert.NoError(t, db.First(&moderated, pending.Id).Error)
+	assert.Equal(t, service.CommentPending, moderated.Status)
+	err = db.Exec("DELETE FROM blogs WHERE id = ?", blog.Id).Error
+	assert.NoError(t, err)
+	var count int64
+	assert.NoError(t, db.Model(&service.Comment{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
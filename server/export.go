@@ -0,0 +1,18 @@
+package server
+
+import (
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+)
+
+func (s *Server) ExportBlogs(req *pb.ExportBlogsRequest, stream grpc.ServerStreamingServer[pb.Blog]) error {
+	err := s.service.ExportBlogs(stream.Context(), req.GetFilter(), func(blog service.Blog) error {
+		return stream.Send(toPBBlog(blog))
+	})
+	if err != nil && stream.Context().Err() == nil {
+		s.logger.Error("got service error ", "error", err)
+		return err
+	}
+	return stream.Context().Err()
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestExportBlogs(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating more blogs than fit in a page
+	var requests []*pb.CreateBlogRequest
+	for range 100 {
+		requests = append(requests, &pb.CreateBlogRequest{Title: "go title", Body: "some body"})
+	}
+	requests[0].Title = "rust title"
+	_, err := tEnv.Client.BatchCreateBlogs(ctx, &pb.BatchCreateBlogsRequest{Requests: requests, AllOrNothing: true})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		request   *pb.ExportBlogsRequest
+		wantCount int
+		wantError *status.Status
+	}{
+		{
+			name:      "should export all blogs successfully",
+			request:   &pb.ExportBlogsRequest{},
+			wantCount: 100,
+		},
+		{
+			name:      "should export filtered blogs successfully",
+			request:   &pb.ExportBlogsRequest{Filter: `title:"rust"`},
+			wantCount: 1,
+		},
+		{
+			name:      "should fail when filter is invalid",
+			request:   &pb.ExportBlogsRequest{Filter: "unknown = 1"},
+			wantError: status.New(codes.InvalidArgument, "invalid filter"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := tEnv.Client.ExportBlogs(ctx, tt.request)
+			assert.NoError(t, err)
+			var blogs []*pb.Blog
+			for {
+				blog, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					assert.NotNil(t, tt.wantError)
+					s, ok := status.FromError(err)
+					assert.True(t, ok)
+					assert.Equal(t, tt.wantError.Code(), s.Code())
+					assert.Contains(t, s.Message(), tt.wantError.Message())
+					return
+				}
+				blogs = append(blogs, blog)
+			}
+			assert.Nil(t, tt.wantError)
+			assert.Equal(t, tt.wantCount, len(blogs))
+			for i := 1; i < len(blogs); i++ {
+				assert.Greater(t, blogs[i].Id, blogs[i-1].Id)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/internal/gateway"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestGateway(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		New(service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret)), logger).Register(reg)
+	})
+	defer tEnv.Cancel()
+	gw, err := gateway.New(ctx, tEnv.Conn, gateway.WithForwardedHeaders(IdempotencyKeyHeader, EditorHeader))
+	assert.NoError(t, err)
+	httpServer := httptest.NewServer(gw)
+	defer httpServer.Close()
+
+	do := func(method string, path string, body string, header http.Header) (int, map[string]any) {
+		req, err := http.NewRequestWithContext(ctx, method, httpServer.URL+path, strings.NewReader(body))
+		assert.NoError(t, err)
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		res, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer func() {
+			_ = res.Body.Close()
+		}()
+		var got map[string]any
+		assert.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+		return res.StatusCode, got
+	}
+
+	// create the same blog twice with an idempotency key
+	header := http.Header{"Idempotency-Key": {"create-hello"}}
+	code, created := do(http.MethodPost, "/v1/blogs", `{"title": "hello", "body": "world", "tags": ["go"]}`, header)
+	assert.Equal(t, http.StatusOK, code)
+	code, again := do(http.MethodPost, "/v1/blogs", `{"title": "hello", "body": "world", "tags": ["go"]}`, header)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, created["id"], again["id"])
+	id := int(created["id"].(float64))
+
+	code, got := do(http.MethodGet, "/v1/blogs/"+strconv.Itoa(id), "", nil)
+	assert.Equal(t, http.StatusOK, code)
+	item := got["item"].(map[string]any)
+	assert.Equal(t, "hello", item["title"])
+	assert.Equal(t, "BLOG_STATUS_PUBLISHED", item["status"])
+	assert.Equal(t, []any{"go"}, item["tags"])
+
+	code, _ = do(http.MethodPatch, "/v1/blogs/"+strconv.Itoa(id), `{"title": "hello again"}`, nil)
+	assert.Equal(t, http.StatusOK, code)
+	code, list := do(http.MethodGet, "/v1/blogs?tag=go&limit=10", "", nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "1", list["total_items"])
+	assert.Equal(t, "hello again", list["items"].([]any)[0].(map[string]any)["title"])
+
+	// requests go through the validation interceptor and errors keep their status code
+	code, got = do(http.MethodPost, "/v1/blogs", `{"title": "hi"}`, nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, float64(3), got["code"])
+	assert.Contains(t, got["message"], "validation failed")
+
+	code, got = do(http.MethodGet, "/v1/blogs/"+strconv.Itoa(id+1000), "", nil)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, float64(5), got["code"])
+
+	code, _ = do(http.MethodDelete, "/v1/blogs/"+strconv.Itoa(id), "", nil)
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = do(http.MethodGet, "/v1/blogs/"+strconv.Itoa(id), "", nil)
+	assert.Equal(t, http.StatusNotFound, code)
+}
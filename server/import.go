@@ -0,0 +1,118 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"buf.build/go/protovalidate"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// importBatchSize is the number of blogs inserted together
+	importBatchSize = 500
+	// maxImportErrors caps the error details returned by ImportBlogs
+	maxImportErrors = 1000
+)
+
+func (s *Server) ImportBlogs(stream grpc.ClientStreamingServer[pb.ImportBlogsRequest, pb.ImportBlogsResponse]) error {
+	ctx := editorContext(stream.Context())
+	var res pb.ImportBlogsResponse
+	fail := func(index int, sourceID string, err error) {
+		res.Failed++
+		if len(res.Errors) < maxImportErrors {
+			res.Errors = append(res.Errors, &pb.ImportError{
+				Index:    int32(index),
+				SourceId: sourceID,
+				Status:   status.Convert(err).Proto(),
+			})
+		}
+	}
+
+	var blogs []service.Blog
+	var indexes []int
+	flush := func() error {
+		if len(blogs) == 0 {
+			return nil
+		}
+		sRes, err := s.service.ImportBlogs(ctx, blogs)
+		if err != nil {
+			s.logger.Error("got service error ", "error", err)
+			return err
+		}
+		for i, result := range sRes {
+			switch result.Outcome {
+			case service.ImportInserted:
+				res.Inserted++
+			case service.ImportSkipped:
+				res.Skipped++
+			case service.ImportFailed:
+				var sourceID string
+				if blogs[i].SourceID != nil {
+					sourceID = *blogs[i].SourceID
+				}
+				fail(indexes[i], sourceID, result.Err)
+			}
+		}
+		blogs, indexes = blogs[:0], indexes[:0]
+		return nil
+	}
+
+	for index := 0; ; index++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// validate every blog on its own so that invalid blogs can be reported
+		// without failing the whole import
+		item := req.GetBlog()
+		if item == nil {
+			fail(index, "", status.Error(codes.InvalidArgument, "validation failed: blog is required"))
+			continue
+		}
+		if err := protovalidate.Validate(item); err != nil {
+			fail(index, item.GetSourceId(), status.Error(codes.InvalidArgument, fmt.Sprintf("validation failed: %v", err)))
+			continue
+		}
+		blogs = append(blogs, toImportedBlog(item))
+		indexes = append(indexes, index)
+		if len(blogs) >= importBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	s.logger.Info("imported blogs", "inserted", res.Inserted, "skipped", res.Skipped, "failed", res.Failed)
+	return stream.SendAndClose(&res)
+}
+
+// toImportedBlog converts an imported blog, keeping its timestamps when set
+func toImportedBlog(item *pb.ImportedBlog) service.Blog {
+	blog := service.Blog{
+		Title: item.GetTitle(),
+		Body:  item.GetBody(),
+	}
+	if item.GetSourceId() != "" {
+		sourceID := item.GetSourceId()
+		blog.SourceID = &sourceID
+	}
+	if item.GetCreatedAt() != nil {
+		blog.CreatedAt = item.GetCreatedAt().AsTime()
+	}
+	if item.GetUpdatedAt() != nil {
+		blog.UpdatedAt = item.GetUpdatedAt().AsTime()
+	}
+	return blog
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestImportBlogs(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	createdAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	blogs := []*pb.ImportedBlog{
+		{SourceId: "1", Title: "first title", Body: "first body", CreatedAt: timestamppb.New(createdAt), UpdatedAt: timestamppb.New(createdAt)},
+		{SourceId: "2", Title: "", Body: "invalid body"},
+		{SourceId: "3", Title: "third title", Body: "third body"},
+		{SourceId: "1", Title: "repeated title", Body: "repeated body"},
+		{Title: "no source title", Body: "no source body"},
+	}
+
+	tests := []struct {
+		name         string
+		blogs        []*pb.ImportedBlog
+		wantInserted int32
+		wantSkipped  int32
+		wantFailed   int32
+	}{
+		{
+			name:         "should import valid blogs and report invalid ones",
+			blogs:        blogs,
+			wantInserted: 3,
+			wantSkipped:  1,
+			wantFailed:   1,
+		},
+		{
+			name:         "should skip blogs imported before when resuming",
+			blogs:        blogs[:3],
+			wantInserted: 0,
+			wantSkipped:  2,
+			wantFailed:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := tEnv.Client.ImportBlogs(ctx)
+			assert.NoError(t, err)
+			for _, blog := range tt.blogs {
+				assert.NoError(t, stream.Send(&pb.ImportBlogsRequest{Blog: blog}))
+			}
+			resp, err := stream.CloseAndRecv()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantInserted, resp.Inserted)
+			assert.Equal(t, tt.wantSkipped, resp.Skipped)
+			assert.Equal(t, tt.wantFailed, resp.Failed)
+			assert.Equal(t, 1, len(resp.Errors))
+			assert.Equal(t, int32(1), resp.Errors[0].Index)
+			assert.Equal(t, "2", resp.Errors[0].SourceId)
+			assert.Equal(t, int32(codes.InvalidArgument), resp.Errors[0].Status.Code)
+		})
+	}
+
+	// the original timestamps of the first blog were kept
+	resp, err := tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{Limit: 10, Sort: "created_at asc"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), resp.TotalItems)
+	assert.Equal(t, "first title", resp.Items[0].Title)
+	assert.Equal(t, createdAt, resp.Items[0].CreatedAt.AsTime())
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestProtocols(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		New(service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret)), logger).Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	for name, client := range tEnv.ProtocolClients {
+		t.Run(name, func(t *testing.T) {
+			created, err := client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "hello " + name, Body: "world"})
+			assert.NoError(t, err)
+
+			got, err := client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: created.GetId()}})
+			assert.NoError(t, err)
+			assert.Equal(t, "hello "+name, got.GetItem().GetTitle())
+			assert.Equal(t, "world", got.GetItem().GetBody())
+
+			_, err = client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: created.GetId() + 1000}})
+			assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+			// requests are validated the same way on every protocol
+			_, err = client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "hi", Body: "world"})
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			assert.ErrorContains(t, err, "validation failed")
+			assert.ErrorContains(t, err, "string.min_len")
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	blogStatuses = map[pb.BlogStatus]service.BlogStatus{
+		pb.BlogStatus_BLOG_STATUS_DRAFT:     service.BlogDraft,
+		pb.BlogStatus_BLOG_STATUS_SCHEDULED: service.BlogScheduled,
+		pb.BlogStatus_BLOG_STATUS_PUBLISHED: service.BlogPublished,
+		pb.BlogStatus_BLOG_STATUS_ARCHIVED:  service.BlogArchived,
+	}
+	pbBlogStatuses = map[service.BlogStatus]pb.BlogStatus{
+		service.BlogDraft:     pb.BlogStatus_BLOG_STATUS_DRAFT,
+		service.BlogScheduled: pb.BlogStatus_BLOG_STATUS_SCHEDULED,
+		service.BlogPublished: pb.BlogStatus_BLOG_STATUS_PUBLISHED,
+		service.BlogArchived:  pb.BlogStatus_BLOG_STATUS_ARCHIVED,
+	}
+)
+
+func (s *Server) PublishBlog(ctx context.Context, req *pb.PublishBlogRequest) (*emptypb.Empty, error) {
+	ctx = editorContext(ctx)
+	var publishAt time.Time
+	if req.GetPublishAt() != nil {
+		publishAt = req.GetPublishAt().AsTime()
+	}
+	err := s.service.PublishBlog(ctx, uint(req.GetId()), publishAt, req.GetEtag())
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) UnpublishBlog(ctx context.Context, req *pb.UnpublishBlogRequest) (*emptypb.Empty, error) {
+	ctx = editorContext(ctx)
+	err := s.service.UnpublishBlog(ctx, uint(req.GetId()), req.GetArchive(), req.GetEtag())
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestPublishBlog(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret), service.WithPublishInterval(100*time.Millisecond))
+		go bService.Run(ctx)
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating a draft and a published blog
+	draft, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "draft", Body: "body", Status: pb.BlogStatus_BLOG_STATUS_DRAFT})
+	assert.NoError(t, err)
+	published, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "published", Body: "body"})
+	assert.NoError(t, err)
+
+	// only published blogs are listed unless asked otherwise
+	blogs, err := tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), blogs.TotalItems)
+	assert.Equal(t, published.Id, blogs.Items[0].Id)
+	assert.Equal(t, pb.BlogStatus_BLOG_STATUS_PUBLISHED, blogs.Items[0].Status)
+	assert.NotNil(t, blogs.Items[0].PublishAt)
+	blogs, err = tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{Statuses: []pb.BlogStatus{pb.BlogStatus_BLOG_STATUS_DRAFT}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), blogs.TotalItems)
+	assert.Equal(t, draft.Id, blogs.Items[0].Id)
+
+	tests := []struct {
+		name      string
+		request   *pb.PublishBlogRequest
+		wantError *status.Status
+	}{
+		{
+			name:      "should fail when blog does not exist",
+			request:   &pb.PublishBlogRequest{Id: draft.Id + 1000},
+			wantError: status.New(codes.NotFound, "blog not found"),
+		},
+		{
+			name:      "should fail when scheduling a published blog",
+			request:   &pb.PublishBlogRequest{Id: published.Id, PublishAt: timestamppb.New(time.Now().Add(time.Hour))},
+			wantError: status.New(codes.FailedPrecondition, "blog is already published, unpublish it before scheduling it"),
+		},
+		{
+			name:      "should fail when etag is stale",
+			request:   &pb.PublishBlogRequest{Id: draft.Id, Etag: "100"},
+			wantError: status.New(codes.Aborted, "blog was modified, etag does not match"),
+		},
+		{
+			name:      "should not get error when publishing a published blog",
+			request:   &pb.PublishBlogRequest{Id: published.Id},
+			wantError: nil,
+		},
+		{
+			name:      "should schedule blog successfully",
+			request:   &pb.PublishBlogRequest{Id: draft.Id, PublishAt: timestamppb.New(time.Now().Add(time.Second))},
+			wantError: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tEnv.Client.PublishBlog(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Equal(t, tt.wantError.Message(), s.Message())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+
+	got, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: draft.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.BlogStatus_BLOG_STATUS_SCHEDULED, got.Item.Status)
+
+	// the scheduler publishes the blog once its time has come
+	assert.Eventually(t, func() bool {
+		got, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: draft.Id}})
+		return err == nil && got.Item.Status == pb.BlogStatus_BLOG_STATUS_PUBLISHED
+	}, 5*time.Second, 100*time.Millisecond)
+
+	_, err = tEnv.Client.UnpublishBlog(ctx, &pb.UnpublishBlogRequest{Id: published.Id, Archive: true})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.UnpublishBlog(ctx, &pb.UnpublishBlogRequest{Id: draft.Id})
+	assert.NoError(t, err)
+	got, err = tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: draft.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.BlogStatus_BLOG_STATUS_DRAFT, got.Item.Status)
+	assert.Nil(t, got.Item.PublishAt)
+
+	blogs, err = tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), blogs.TotalItems)
+	blogs, err = tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{Statuses: []pb.BlogStatus{pb.BlogStatus_BLOG_STATUS_ARCHIVED}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), blogs.TotalItems)
+}
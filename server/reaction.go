@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	reactionTypes = map[pb.ReactionType]service.ReactionType{
+		pb.ReactionType_REACTION_TYPE_LIKE:       service.ReactionLike,
+		pb.ReactionType_REACTION_TYPE_LOVE:       service.ReactionLove,
+		pb.ReactionType_REACTION_TYPE_LAUGH:      service.ReactionLaugh,
+		pb.ReactionType_REACTION_TYPE_INSIGHTFUL: service.ReactionInsightful,
+		pb.ReactionType_REACTION_TYPE_CELEBRATE:  service.ReactionCelebrate,
+	}
+	pbReactionTypes = map[service.ReactionType]pb.ReactionType{
+		service.ReactionLike:       pb.ReactionType_REACTION_TYPE_LIKE,
+		service.ReactionLove:       pb.ReactionType_REACTION_TYPE_LOVE,
+		service.ReactionLaugh:      pb.ReactionType_REACTION_TYPE_LAUGH,
+		service.ReactionInsightful: pb.ReactionType_REACTION_TYPE_INSIGHTFUL,
+		service.ReactionCelebrate:  pb.ReactionType_REACTION_TYPE_CELEBRATE,
+	}
+)
+
+func (s *Server) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*emptypb.Empty, error) {
+	err := s.service.AddReaction(ctx, service.Reaction{
+		BlogID: uint(req.GetBlogId()),
+		UserID: req.GetUserId(),
+		Type:   reactionTypes[req.GetType()],
+	})
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*emptypb.Empty, error) {
+	err := s.service.RemoveReaction(ctx, service.Reaction{
+		BlogID: uint(req.GetBlogId()),
+		UserID: req.GetUserId(),
+		Type:   reactionTypes[req.GetType()],
+	})
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListReactions(ctx context.Context, req *pb.ListReactionsRequest) (*pb.ListReactionsResponse, error) {
+	pagination := service.Pagination{
+		Limit: int(req.GetLimit()),
+		Page:  int(req.GetPage()),
+	}
+	sRes, err := s.service.GetReactions(ctx, uint(req.GetBlogId()), reactionTypes[req.GetType()], &pagination)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.ListReactionsResponse
+	for _, reaction := range sRes.Items.([]service.Reaction) {
+		res.Items = append(res.Items, &pb.Reaction{
+			BlogId:    uint32(reaction.BlogID),
+			UserId:    reaction.UserID,
+			Type:      pbReactionTypes[reaction.Type],
+			CreatedAt: timestamppb.New(reaction.CreatedAt),
+		})
+	}
+	res.Limit = int32(sRes.Limit)
+	res.Page = int32(sRes.Page)
+	res.TotalItems = sRes.TotalItems
+	res.TotalPages = int32(sRes.TotalPages)
+	return &res, nil
+}
+
+// toPBReactionCounts converts the reaction counts of a blog into their protobuf representation
+func toPBReactionCounts(counts []service.ReactionCount) []*pb.ReactionCount {
+	var res []*pb.ReactionCount
+	for _, count := range counts {
+		res = append(res, &pb.ReactionCount{
+			Type:  pbReactionTypes[count.Type],
+			Count: count.Count,
+		})
+	}
+	return res
+}
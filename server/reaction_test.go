@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestReactions(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		New(service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret)), logger).Register(reg)
+	})
+	defer tEnv.Cancel()
+	client := tEnv.Client
+
+	// prepare test by creating a blog and a draft
+	blog, err := client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "blog", Body: "body"})
+	assert.NoError(t, err)
+	draft, err := client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "draft", Body: "body", Status: pb.BlogStatus_BLOG_STATUS_DRAFT})
+	assert.NoError(t, err)
+
+	addTests := []struct {
+		name      string
+		request   *pb.AddReactionRequest
+		wantError *status.Status
+	}{
+		{
+			name:    "should add reaction",
+			request: &pb.AddReactionRequest{BlogId: blog.Id, UserId: "ada", Type: pb.ReactionType_REACTION_TYPE_LIKE},
+		},
+		{
+			name:    "should ignore repeated reaction",
+			request: &pb.AddReactionRequest{BlogId: blog.Id, UserId: "ada", Type: pb.ReactionType_REACTION_TYPE_LIKE},
+		},
+		{
+			name:    "should add reaction of another type",
+			request: &pb.AddReactionRequest{BlogId: blog.Id, UserId: "ada", Type: pb.ReactionType_REACTION_TYPE_LOVE},
+		},
+		{
+			name:    "should add reaction of another user",
+			request: &pb.AddReactionRequest{BlogId: blog.Id, UserId: "grace", Type: pb.ReactionType_REACTION_TYPE_LIKE},
+		},
+		{
+			name:      "should fail when type is not set",
+			request:   &pb.AddReactionRequest{BlogId: blog.Id, UserId: "ada"},
+			wantError: status.New(codes.InvalidArgument, "enum.not_in"),
+		},
+		{
+			name:      "should fail when blog is not published",
+			request:   &pb.AddReactionRequest{BlogId: draft.Id, UserId: "ada", Type: pb.ReactionType_REACTION_TYPE_LIKE},
+			wantError: status.New(codes.FailedPrecondition, "reactions can only be added to published blogs"),
+		},
+		{
+			name:      "should fail when blog does not exist",
+			request:   &pb.AddReactionRequest{BlogId: draft.Id + 1000, UserId: "ada", Type: pb.ReactionType_REACTION_TYPE_LIKE},
+			wantError: status.New(codes.NotFound, "blog not found"),
+		},
+	}
+	for _, tt := range addTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.AddReaction(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+
+	// the counts are returned with the blog
+	got, err := client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: blog.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[pb.ReactionType]int64{
+		pb.ReactionType_REACTION_TYPE_LIKE: 2,
+		pb.ReactionType_REACTION_TYPE_LOVE: 1,
+	}, reactionCounts(got.Item))
+
+	// the reactions are paged, the most recent first
+	list, err := client.ListReactions(ctx, &pb.ListReactionsRequest{BlogId: blog.Id, Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), list.TotalItems)
+	assert.Equal(t, int32(2), list.TotalPages)
+	assert.Equal(t, "grace", list.Items[0].UserId)
+	list, err = client.ListReactions(ctx, &pb.ListReactionsRequest{BlogId: blog.Id, Type: pb.ReactionType_REACTION_TYPE_LOVE})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), list.TotalItems)
+	assert.Equal(t, "ada", list.Items[0].UserId)
+
+	// removing is idempotent too and types without reactions are not returned
+	for range 2 {
+		_, err = client.RemoveReaction(ctx, &pb.RemoveReactionRequest{BlogId: blog.Id, UserId: "ada", Type: pb.ReactionType_REACTION_TYPE_LOVE})
+		assert.NoError(t, err)
+	}
+	got, err = client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: blog.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[pb.ReactionType]int64{pb.ReactionType_REACTION_TYPE_LIKE: 2}, reactionCounts(got.Item))
+
+	// reactions are gone with their blog
+	err = db.Exec("DELETE FROM blogs WHERE id = ?", blog.Id).Error
+	assert.NoError(t, err)
+	var count int64
+	assert.NoError(t, db.Model(&service.Reaction{}).Count(&count).Error)
+	assert.Zero(t, count)
+	assert.NoError(t, db.Model(&service.ReactionCount{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+// reactionCounts returns the reaction counts of the blog by type
+func reactionCounts(blog *pb.Blog) map[pb.ReactionType]int64 {
+	counts := map[pb.ReactionType]int64{}
+	for _, count := range blog.GetReactions() {
+		counts[count.GetType()] = count.GetCount()
+	}
+	return counts
+}
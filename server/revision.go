@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	revisionActions = map[service.RevisionAction]pb.RevisionAction{
+		service.RevisionCreated:   pb.RevisionAction_REVISION_ACTION_CREATED,
+		service.RevisionUpdated:   pb.RevisionAction_REVISION_ACTION_UPDATED,
+		service.RevisionDeleted:   pb.RevisionAction_REVISION_ACTION_DELETED,
+		service.RevisionUndeleted: pb.RevisionAction_REVISION_ACTION_UNDELETED,
+	}
+	diffOperations = map[service.DiffOperation]pb.DiffLine_Operation{
+		service.DiffEqual:  pb.DiffLine_OPERATION_EQUAL,
+		service.DiffInsert: pb.DiffLine_OPERATION_INSERT,
+		service.DiffDelete: pb.DiffLine_OPERATION_DELETE,
+	}
+)
+
+func (s *Server) ListBlogRevisions(ctx context.Context, req *pb.ListBlogRevisionsRequest) (*pb.ListBlogRevisionsResponse, error) {
+	pagination := service.Pagination{
+		Limit: int(req.GetLimit()),
+		Page:  int(req.GetPage()),
+	}
+	sRes, err := s.service.GetBlogRevisions(ctx, uint(req.GetBlogId()), &pagination)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.ListBlogRevisionsResponse
+	for _, revision := range sRes.Items.([]service.BlogRevision) {
+		res.Items = append(res.Items, toPBBlogRevision(revision))
+	}
+	res.Limit = int32(sRes.Limit)
+	res.Page = int32(sRes.Page)
+	res.TotalItems = sRes.TotalItems
+	res.TotalPages = int32(sRes.TotalPages)
+	return &res, nil
+}
+
+func (s *Server) GetBlogRevision(ctx context.Context, req *pb.GetBlogRevisionRequest) (*pb.BlogRevision, error) {
+	sRes, err := s.service.GetBlogRevision(ctx, uint(req.GetBlogId()), uint(req.GetRevision()))
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return toPBBlogRevision(*sRes), nil
+}
+
+func (s *Server) RestoreBlogRevision(ctx context.Context, req *pb.RestoreBlogRevisionRequest) (*emptypb.Empty, error) {
+	ctx = editorContext(ctx)
+	err := s.service.RestoreBlogRevision(ctx, uint(req.GetBlogId()), uint(req.GetRevision()), req.GetEtag())
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) DiffBlogRevisions(ctx context.Context, req *pb.DiffBlogRevisionsRequest) (*pb.DiffBlogRevisionsResponse, error) {
+	title, body, err := s.service.DiffBlogRevisions(ctx, uint(req.GetBlogId()), uint(req.GetFromRevision()), uint(req.GetToRevision()))
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &pb.DiffBlogRevisionsResponse{
+		Title: toPBDiffLines(title),
+		Body:  toPBDiffLines(body),
+	}, nil
+}
+
+func toPBBlogRevision(revision service.BlogRevision) *pb.BlogRevision {
+	return &pb.BlogRevision{
+		BlogId:    uint32(revision.BlogID),
+		Revision:  uint32(revision.Number),
+		Action:    revisionActions[revision.Action],
+		Title:     revision.Title,
+		Body:      revision.Body,
+		Editor:    revision.Editor,
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+	}
+}
+
+func toPBDiffLines(lines []service.DiffLine) []*pb.DiffLine {
+	res := make([]*pb.DiffLine, len(lines))
+	for i, line := range lines {
+		res[i] = &pb.DiffLine{
+			Operation: diffOperations[line.Operation],
+			Text:      line.Text,
+		}
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestBlogRevisions(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating and updating a new entry as different editors
+	alice := metadata.AppendToOutgoingContext(ctx, EditorHeader, "alice")
+	bob := metadata.AppendToOutgoingContext(ctx, EditorHeader, "bob")
+	resp, err := tEnv.Client.CreateBlog(alice, &pb.CreateBlogRequest{Title: "title", Body: "first line\nsecond line"})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.UpdateBlog(bob, &pb.UpdateBlogRequest{Id: resp.Id, Body: proto.String("first line\nchanged line")})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		request   *pb.RestoreBlogRevisionRequest
+		wantError *status.Status
+	}{
+		{
+			name:      "should fail when etag is stale",
+			request:   &pb.RestoreBlogRevisionRequest{BlogId: resp.Id, Revision: 1, Etag: "1"},
+			wantError: status.New(codes.Aborted, "blog was modified, etag does not match"),
+		},
+		{
+			name:      "should fail when revision does not exist",
+			request:   &pb.RestoreBlogRevisionRequest{BlogId: resp.Id, Revision: 100},
+			wantError: status.New(codes.NotFound, "blog revision not found"),
+		},
+		{
+			name:      "should restore revision successfully",
+			request:   &pb.RestoreBlogRevisionRequest{BlogId: resp.Id, Revision: 1, Etag: "2"},
+			wantError: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tEnv.Client.RestoreBlogRevision(alice, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Equal(t, tt.wantError.Message(), s.Message())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+
+	got, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: resp.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, "first line\nsecond line", got.Item.Body)
+
+	// updates that don't change the title or body record no revision
+	_, err = tEnv.Client.UpdateBlog(bob, &pb.UpdateBlogRequest{Id: resp.Id, Body: proto.String("first line\nsecond line")})
+	assert.NoError(t, err)
+
+	_, err = tEnv.Client.DeleteBlog(bob, &pb.DeleteBlogRequest{Id: resp.Id})
+	assert.NoError(t, err)
+
+	revisions, err := tEnv.Client.ListBlogRevisions(ctx, &pb.ListBlogRevisionsRequest{BlogId: resp.Id})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), revisions.TotalItems)
+	wantActions := []pb.RevisionAction{
+		pb.RevisionAction_REVISION_ACTION_DELETED,
+		pb.RevisionAction_REVISION_ACTION_UPDATED,
+		pb.RevisionAction_REVISION_ACTION_UPDATED,
+		pb.RevisionAction_REVISION_ACTION_CREATED,
+	}
+	wantEditors := []string{"bob", "alice", "bob", "alice"}
+	for i, revision := range revisions.Items {
+		assert.Equal(t, uint32(4-i), revision.Revision)
+		assert.Equal(t, wantActions[i], revision.Action)
+		assert.Equal(t, wantEditors[i], revision.Editor)
+	}
+
+	revision, err := tEnv.Client.GetBlogRevision(ctx, &pb.GetBlogRevisionRequest{BlogId: resp.Id, Revision: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "first line\nchanged line", revision.Body)
+
+	diff, err := tEnv.Client.DiffBlogRevisions(ctx, &pb.DiffBlogRevisionsRequest{BlogId: resp.Id, FromRevision: 2, ToRevision: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []pb.DiffLine_Operation{pb.DiffLine_OPERATION_EQUAL}, diffOperationsOf(diff.Title))
+	assert.Equal(t, []pb.DiffLine_Operation{
+		pb.DiffLine_OPERATION_EQUAL,
+		pb.DiffLine_OPERATION_DELETE,
+		pb.DiffLine_OPERATION_INSERT,
+	}, diffOperationsOf(diff.Body))
+	assert.Equal(t, "second line", diff.Body[2].Text)
+}
+
+func diffOperationsOf(lines []*pb.DiffLine) []pb.DiffLine_Operation {
+	var operations []pb.DiffLine_Operation
+	for _, line := range lines {
+		operations = append(operations, line.Operation)
+	}
+	return operations
+}
@@ -7,10 +7,21 @@ import (
 	"github.com/susana-garcia/go-crud/pb"
 	"github.com/susana-garcia/go-crud/service"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// IdempotencyKeyHeader is the metadata header used to deduplicate retried requests
+const IdempotencyKeyHeader = "idempotency-key"
+
+const maxIdempotencyKeyLength = 255
+
+// EditorHeader is the metadata header naming the editor recorded in the revisions of the changes
+const EditorHeader = "editor"
+
 type Server struct {
 	service service.Blogger
 	logger  *slog.Logger
@@ -30,9 +41,16 @@ func (s *Server) Register(server grpc.ServiceRegistrar) {
 
 func (s *Server) GetBlogs(ctx context.Context, req *pb.GetBlogsRequest) (*pb.GetBlogsResponse, error) {
 	pagination := service.Pagination{
-		Limit: int(req.GetLimit()),
-		Page:  int(req.GetPage()),
-		Sort:  req.GetSort(),
+		Limit:     int(req.GetLimit()),
+		Page:      int(req.GetPage()),
+		Sort:      req.GetSort(),
+		Filter:    req.GetFilter(),
+		PageToken: req.GetPageToken(),
+		Tag:       req.GetTag(),
+		AuthorID:  uint(req.GetAuthorId()),
+	}
+	for _, st := range req.GetStatuses() {
+		pagination.Statuses = append(pagination.Statuses, blogStatuses[st])
 	}
 	sRes, err := s.service.GetAllBlogs(ctx, &pagination)
 	if err != nil {
@@ -41,44 +59,53 @@ func (s *Server) GetBlogs(ctx context.Context, req *pb.GetBlogsRequest) (*pb.Get
 	}
 	var res pb.GetBlogsResponse
 	for _, blog := range sRes.Items.([]service.Blog) {
-		res.Items = append(res.Items, &pb.Blog{
-			Id:        uint32(blog.ID),
-			Title:     blog.Title,
-			Body:      blog.Body,
-			CreatedAt: timestamppb.New(blog.CreatedAt),
-			UpdatedAt: timestamppb.New(blog.UpdatedAt),
-		})
+		res.Items = append(res.Items, toPBBlog(blog))
 	}
 	res.Limit = int32(sRes.Limit)
 	res.Page = int32(sRes.Page)
 	res.Sort = sRes.Sort
-	res.TotalItems = int32(sRes.TotalItems)
+	res.Filter = sRes.Filter
+	res.TotalItems = sRes.TotalItems
 	res.TotalPages = int32(sRes.TotalPages)
+	res.NextPageToken = sRes.NextPageToken
 	return &res, nil
 }
 
 func (s *Server) GetBlog(ctx context.Context, req *pb.GetBlogRequest) (*pb.GetBlogResponse, error) {
-	sRes, err := s.service.GetBlogByIDOrTitle(ctx, uint(req.GetId()), req.GetTitle())
+	var sRes *service.Blog
+	var moved bool
+	var err error
+	if req.GetSlug() != "" {
+		sRes, moved, err = s.service.GetBlogBySlug(ctx, req.GetSlug())
+	} else {
+		sRes, err = s.service.GetBlogByIDOrTitle(ctx, uint(req.GetId()), req.GetTitle())
+	}
 	if err != nil {
 		s.logger.Error("got service error ", "error", err)
 		return nil, err
 	}
+	if req.GetCountView() {
+		s.service.CountView(sRes)
+	}
 	return &pb.GetBlogResponse{
-		Item: &pb.Blog{
-			Id:        uint32(sRes.ID),
-			Title:     sRes.Title,
-			Body:      sRes.Body,
-			CreatedAt: timestamppb.New(sRes.CreatedAt),
-			UpdatedAt: timestamppb.New(sRes.UpdatedAt),
-		},
-	}, err
+		Item:  toPBBlog(*sRes),
+		Moved: moved,
+	}, nil
 }
 
 func (s *Server) CreateBlog(ctx context.Context, req *pb.CreateBlogRequest) (*pb.CreateBlogResponse, error) {
+	ctx = editorContext(ctx)
+	key, err := idempotencyKey(ctx)
+	if err != nil {
+		return nil, err
+	}
 	id, err := s.service.CreateBlog(ctx, service.Blog{
-		Title: req.GetTitle(),
-		Body:  req.GetBody(),
-	})
+		Title:    req.GetTitle(),
+		Body:     req.GetBody(),
+		Status:   blogStatuses[req.GetStatus()],
+		Tags:     service.NewTags(req.GetTags()),
+		AuthorID: authorID(req.GetAuthorId()),
+	}, key)
 	if err != nil {
 		s.logger.Error("got service error ", "error", err)
 		return nil, err
@@ -89,11 +116,30 @@ func (s *Server) CreateBlog(ctx context.Context, req *pb.CreateBlogRequest) (*pb
 }
 
 func (s *Server) UpdateBlog(ctx context.Context, req *pb.UpdateBlogRequest) (*emptypb.Empty, error) {
+	ctx = editorContext(ctx)
+	// without an update mask, only the fields present in the request are updated
+	fields := req.GetUpdateMask().GetPaths()
+	if req.GetUpdateMask() == nil {
+		if req.Title != nil {
+			fields = append(fields, "title")
+		}
+		if req.Body != nil {
+			fields = append(fields, "body")
+		}
+		if len(req.GetTags()) > 0 {
+			fields = append(fields, "tags")
+		}
+		if req.AuthorId != nil {
+			fields = append(fields, "author_id")
+		}
+	}
 	err := s.service.UpdateBlog(ctx, service.Blog{
-		ID:    uint(req.GetId()),
-		Title: req.GetTitle(),
-		Body:  req.GetBody(),
-	})
+		ID:       uint(req.GetId()),
+		Title:    req.GetTitle(),
+		Body:     req.GetBody(),
+		Tags:     service.NewTags(req.GetTags()),
+		AuthorID: authorID(req.GetAuthorId()),
+	}, fields, req.GetEtag())
 	if err != nil {
 		s.logger.Error("got service error ", "error", err)
 		return nil, err
@@ -102,10 +148,134 @@ func (s *Server) UpdateBlog(ctx context.Context, req *pb.UpdateBlogRequest) (*em
 }
 
 func (s *Server) DeleteBlog(ctx context.Context, req *pb.DeleteBlogRequest) (*emptypb.Empty, error) {
-	err := s.service.DeleteBlog(ctx, uint(req.GetId()))
+	ctx = editorContext(ctx)
+	err := s.service.DeleteBlog(ctx, uint(req.GetId()), req.GetEtag())
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) UndeleteBlog(ctx context.Context, req *pb.UndeleteBlogRequest) (*emptypb.Empty, error) {
+	ctx = editorContext(ctx)
+	err := s.service.UndeleteBlog(ctx, uint(req.GetId()))
 	if err != nil {
 		s.logger.Error("got service error ", "error", err)
 		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *Server) ListDeletedBlogs(ctx context.Context, req *pb.ListDeletedBlogsRequest) (*pb.ListDeletedBlogsResponse, error) {
+	pagination := service.Pagination{
+		Limit: int(req.GetLimit()),
+		Page:  int(req.GetPage()),
+	}
+	sRes, err := s.service.GetDeletedBlogs(ctx, &pagination)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.ListDeletedBlogsResponse
+	for _, blog := range sRes.Items.([]service.Blog) {
+		res.Items = append(res.Items, toPBBlog(blog))
+	}
+	res.Limit = int32(sRes.Limit)
+	res.Page = int32(sRes.Page)
+	res.TotalItems = sRes.TotalItems
+	res.TotalPages = int32(sRes.TotalPages)
+	return &res, nil
+}
+
+func (s *Server) SearchBlogs(ctx context.Context, req *pb.SearchBlogsRequest) (*pb.SearchBlogsResponse, error) {
+	pagination := service.Pagination{
+		Limit:     int(req.GetLimit()),
+		Page:      int(req.GetPage()),
+		PageToken: req.GetPageToken(),
+	}
+	sRes, err := s.service.SearchBlogs(ctx, req.GetQuery(), &pagination)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.SearchBlogsResponse
+	for _, result := range sRes.Items.([]service.SearchResult) {
+		res.Results = append(res.Results, &pb.SearchResult{
+			Item:           toPBBlog(result.Blog),
+			Rank:           float32(result.Rank),
+			TitleHighlight: result.TitleHighlight,
+			BodyHighlight:  result.BodyHighlight,
+		})
+	}
+	res.Limit = int32(sRes.Limit)
+	res.Page = int32(sRes.Page)
+	res.TotalItems = sRes.TotalItems
+	res.TotalPages = int32(sRes.TotalPages)
+	res.NextPageToken = sRes.NextPageToken
+	return &res, nil
+}
+
+// toPBBlog converts a service blog into its protobuf representation
+func toPBBlog(blog service.Blog) *pb.Blog {
+	res := &pb.Blog{
+		Id:        uint32(blog.ID),
+		Title:     blog.Title,
+		Body:      blog.Body,
+		CreatedAt: timestamppb.New(blog.CreatedAt),
+		UpdatedAt: timestamppb.New(blog.UpdatedAt),
+		Etag:      blog.ETag(),
+		Status:    pbBlogStatuses[blog.Status],
+		Slug:      blog.Slug,
+		Tags:      service.TagNames(blog.Tags),
+		Reactions: toPBReactionCounts(blog.ReactionCounts),
+	}
+	if blog.DeletedAt.Valid {
+		res.DeletedAt = timestamppb.New(blog.DeletedAt.Time)
+	}
+	if blog.PublishAt != nil {
+		res.PublishAt = timestamppb.New(*blog.PublishAt)
+	}
+	if blog.Author != nil {
+		res.Author = &pb.AuthorSummary{
+			Id:        uint32(blog.Author.ID),
+			Handle:    blog.Author.Handle,
+			Name:      blog.Author.Name,
+			AvatarUrl: blog.Author.AvatarURL,
+		}
+	}
+	return res
+}
+
+// idempotencyKey returns the idempotency key sent in the request metadata, if any
+func idempotencyKey(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return "", nil
+	}
+	if len(values) > 1 {
+		return "", status.Errorf(codes.InvalidArgument, "only one %s header is allowed", IdempotencyKeyHeader)
+	}
+	if len(values[0]) > maxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", IdempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+	return values[0], nil
+}
+
+// editorContext returns the context with the editor sent in the request
+// metadata, if any
+func editorContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	values := md.Get(EditorHeader)
+	if len(values) == 0 {
+		return ctx
+	}
+	return service.WithEditor(ctx, values[0])
+}
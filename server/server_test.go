@@ -4,14 +4,22 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/susana-garcia/go-crud/pb"
 	"github.com/susana-garcia/go-crud/service"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
 
 	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
 )
@@ -26,7 +34,7 @@ func TestCreateBlog(t *testing.T) {
 	assert.NoError(t, dbErr)
 
 	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
-		bService := service.New(db, logger)
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
 		srv := New(bService, logger)
 		srv.Register(reg)
 	})
@@ -81,6 +89,82 @@ func TestCreateBlog(t *testing.T) {
 	}
 }
 
+func TestCreateBlogWithIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	keyCtx := metadata.AppendToOutgoingContext(ctx, IdempotencyKeyHeader, "create-once")
+	first, err := tEnv.Client.CreateBlog(keyCtx, &pb.CreateBlogRequest{
+		Title: "title",
+		Body:  "body",
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *pb.CreateBlogRequest
+		wantSame  bool
+		wantError *status.Status
+	}{
+		{
+			name: "should return original response when retried with same key",
+			ctx:  keyCtx,
+			request: &pb.CreateBlogRequest{
+				Title: "title",
+				Body:  "body",
+			},
+			wantSame:  true,
+			wantError: nil,
+		},
+		{
+			name: "should fail when key is reused with a different payload",
+			ctx:  keyCtx,
+			request: &pb.CreateBlogRequest{
+				Title: "other title",
+				Body:  "body",
+			},
+			wantError: status.New(codes.FailedPrecondition, "different request"),
+		},
+		{
+			name: "should create a new blog with another key",
+			ctx:  metadata.AppendToOutgoingContext(ctx, IdempotencyKeyHeader, "create-twice"),
+			request: &pb.CreateBlogRequest{
+				Title: "title",
+				Body:  "body",
+			},
+			wantSame:  false,
+			wantError: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tEnv.Client.CreateBlog(tt.ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.wantSame, first.Id == resp.Id)
+			}
+		})
+	}
+}
+
 func TestUpdateBlog(t *testing.T) {
 	ctx := context.Background()
 
@@ -91,7 +175,7 @@ func TestUpdateBlog(t *testing.T) {
 	assert.NoError(t, dbErr)
 
 	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
-		bService := service.New(db, logger)
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
 		srv := New(bService, logger)
 		srv.Register(reg)
 	})
@@ -106,53 +190,178 @@ func TestUpdateBlog(t *testing.T) {
 
 	tests := []struct {
 		name      string
-		request   service.Blog
+		request   *pb.UpdateBlogRequest
 		wantError *status.Status
 	}{
 		{
 			name: "should update blog successfully",
-			request: service.Blog{
-				ID:    uint(resp.Id),
-				Title: "new title",
-				Body:  "new body",
+			request: &pb.UpdateBlogRequest{
+				Id:    resp.Id,
+				Title: proto.String("new title"),
+				Body:  proto.String("new body"),
 			},
 			wantError: nil,
 		},
 		{
 			name: "should update blog title successfully",
-			request: service.Blog{
-				ID:    uint(resp.Id),
-				Title: "new title",
-				Body:  "",
+			request: &pb.UpdateBlogRequest{
+				Id:    resp.Id,
+				Title: proto.String("new title"),
 			},
 			wantError: nil,
 		},
 		{
 			name: "should update blog body successfully",
-			request: service.Blog{
-				ID:    uint(resp.Id),
-				Title: "",
-				Body:  "new body",
+			request: &pb.UpdateBlogRequest{
+				Id:   resp.Id,
+				Body: proto.String("new body"),
 			},
 			wantError: nil,
 		},
+		{
+			name: "should clear blog body with update mask successfully",
+			request: &pb.UpdateBlogRequest{
+				Id:         resp.Id,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"body"}},
+			},
+			wantError: nil,
+		},
+		{
+			name: "should fail when body is too short",
+			request: &pb.UpdateBlogRequest{
+				Id:   resp.Id,
+				Body: proto.String("ab"),
+			},
+			wantError: status.New(codes.InvalidArgument, "body: value length must be at least 3 characters [string.min_len]"),
+		},
 		{
 			name: "should fail when title and body are empty",
-			request: service.Blog{
-				ID:    uint(resp.Id),
-				Title: "",
-				Body:  "",
+			request: &pb.UpdateBlogRequest{
+				Id: resp.Id,
 			},
 			wantError: status.New(codes.InvalidArgument, "at_least_one_param"),
 		},
+		{
+			name: "should fail when title is cleared with update mask",
+			request: &pb.UpdateBlogRequest{
+				Id:         resp.Id,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+			},
+			wantError: status.New(codes.InvalidArgument, "title_required"),
+		},
+		{
+			name: "should fail when update mask has unknown path",
+			request: &pb.UpdateBlogRequest{
+				Id:         resp.Id,
+				Title:      proto.String("new title"),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "created_at"}},
+			},
+			wantError: status.New(codes.InvalidArgument, "unknown field"),
+		},
+		{
+			name: "should fail when blog does not exist",
+			request: &pb.UpdateBlogRequest{
+				Id:    resp.Id + 1000,
+				Title: proto.String("new title"),
+			},
+			wantError: status.New(codes.NotFound, "blog not found"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := tEnv.Client.UpdateBlog(ctx, &pb.UpdateBlogRequest{
-				Id:    uint32(resp.Id),
-				Title: tt.request.Title,
-				Body:  tt.request.Body,
-			})
+			_, err := tEnv.Client.UpdateBlog(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+
+	// check that the body was cleared by the update mask
+	blog, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{
+		Value: &pb.GetBlogRequest_Id{Id: resp.Id},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "new title", blog.Item.Title)
+	assert.Equal(t, "", blog.Item.Body)
+}
+
+func TestUpdateBlogWithETag(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating a new entry and reading its etag
+	resp, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{
+		Title: "title",
+		Body:  "body",
+	})
+	assert.NoError(t, err)
+	blog, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{
+		Value: &pb.GetBlogRequest_Id{Id: resp.Id},
+	})
+	assert.NoError(t, err)
+	staleETag := blog.Item.Etag
+
+	tests := []struct {
+		name      string
+		request   *pb.UpdateBlogRequest
+		wantError *status.Status
+	}{
+		{
+			name: "should update blog with matching etag successfully",
+			request: &pb.UpdateBlogRequest{
+				Id:    resp.Id,
+				Title: proto.String("new title"),
+				Etag:  staleETag,
+			},
+			wantError: nil,
+		},
+		{
+			name: "should fail when etag is stale",
+			request: &pb.UpdateBlogRequest{
+				Id:    resp.Id,
+				Title: proto.String("other title"),
+				Etag:  staleETag,
+			},
+			wantError: status.New(codes.Aborted, "etag does not match"),
+		},
+		{
+			name: "should fail when etag is malformed",
+			request: &pb.UpdateBlogRequest{
+				Id:    resp.Id,
+				Title: proto.String("other title"),
+				Etag:  "abc",
+			},
+			wantError: status.New(codes.InvalidArgument, "etag"),
+		},
+		{
+			name: "should update blog without etag successfully",
+			request: &pb.UpdateBlogRequest{
+				Id:    resp.Id,
+				Title: proto.String("other title"),
+			},
+			wantError: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tEnv.Client.UpdateBlog(ctx, tt.request)
 			if tt.wantError != nil {
 				s, ok := status.FromError(err)
 				assert.True(t, ok)
@@ -163,6 +372,12 @@ func TestUpdateBlog(t *testing.T) {
 			}
 		})
 	}
+
+	// a stale etag must not delete the blog either
+	_, err = tEnv.Client.DeleteBlog(ctx, &pb.DeleteBlogRequest{Id: resp.Id, Etag: staleETag})
+	s, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Aborted, s.Code())
 }
 
 func TestGetBlog(t *testing.T) {
@@ -175,7 +390,7 @@ func TestGetBlog(t *testing.T) {
 	assert.NoError(t, dbErr)
 
 	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
-		bService := service.New(db, logger)
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
 		srv := New(bService, logger)
 		srv.Register(reg)
 	})
@@ -248,7 +463,7 @@ func TestGetBlogs(t *testing.T) {
 	assert.NoError(t, dbErr)
 
 	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
-		bService := service.New(db, logger)
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
 		srv := New(bService, logger)
 		srv.Register(reg)
 	})
@@ -268,6 +483,7 @@ func TestGetBlogs(t *testing.T) {
 		name           string
 		request        *pb.GetBlogsRequest
 		expectedAmount int
+		wantError      *status.Status
 	}{
 		{
 			name: "should get blogs with pagination successfully",
@@ -296,16 +512,282 @@ func TestGetBlogs(t *testing.T) {
 			},
 			expectedAmount: 0,
 		},
+		{
+			name: "should get blogs sorted by multiple fields successfully",
+			request: &pb.GetBlogsRequest{
+				Limit: 5,
+				Page:  1,
+				Sort:  "created_at desc, title asc",
+			},
+			expectedAmount: 5,
+		},
+		{
+			name: "should fail when sorting by unknown field",
+			request: &pb.GetBlogsRequest{
+				Limit: 5,
+				Page:  1,
+				Sort:  "id; DROP TABLE blogs",
+			},
+			wantError: status.New(codes.InvalidArgument, "invalid sort"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			resp, err := tEnv.Client.GetBlogs(ctx, tt.request)
-			assert.Nil(t, err)
-			assert.Equal(t, tt.expectedAmount, len(resp.Items))
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expectedAmount, len(resp.Items))
+			}
 		})
 	}
 }
 
+func TestGetBlogsWithPageToken(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	queries := &queryRecorder{Interface: gormlogger.Discard}
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db.Session(&gorm.Session{Logger: queries}), logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating a new entries
+	for i := 1; i < 11; i++ {
+		_, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{
+			Title: "title",
+			Body:  "body",
+		})
+		assert.NoError(t, err)
+	}
+
+	// walk all pages with page tokens, inserting a blog in between
+	seen := map[uint32]bool{}
+	resp, err := tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{Limit: 3, Sort: "created_at asc"})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{
+		Title: "title",
+		Body:  "body",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), resp.TotalItems)
+	queries.reset()
+	for {
+		for _, blog := range resp.Items {
+			assert.False(t, seen[blog.Id], "blog %d returned twice", blog.Id)
+			seen[blog.Id] = true
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		resp, err = tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{Limit: 3, PageToken: resp.NextPageToken})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 11, len(seen))
+	// only paging by page number counts the blogs
+	assert.Equal(t, int64(0), resp.TotalItems)
+	for _, query := range queries.get() {
+		assert.NotContains(t, strings.ToLower(query), "count(")
+	}
+
+	tests := []struct {
+		name      string
+		request   *pb.GetBlogsRequest
+		wantError *status.Status
+	}{
+		{
+			name:      "should fail when page token is invalid",
+			request:   &pb.GetBlogsRequest{PageToken: "invalid"},
+			wantError: status.New(codes.InvalidArgument, "invalid page token"),
+		},
+		{
+			name:      "should fail when page and page token are set",
+			request:   &pb.GetBlogsRequest{Page: 2, PageToken: "invalid"},
+			wantError: status.New(codes.InvalidArgument, "page_or_page_token"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tEnv.Client.GetBlogs(ctx, tt.request)
+			s, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantError.Code(), s.Code())
+			assert.Contains(t, s.Message(), tt.wantError.Message())
+		})
+	}
+}
+
+func TestGetBlogsWithFilter(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating a new entries
+	for _, title := range []string{"learning go", "go generics", "rust basics", "python tips"} {
+		_, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{
+			Title: title,
+			Body:  "body",
+		})
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name           string
+		request        *pb.GetBlogsRequest
+		expectedAmount int
+		expectedTotal  int64
+		wantError      *status.Status
+	}{
+		{
+			name: "should filter blogs by title successfully",
+			request: &pb.GetBlogsRequest{
+				Limit:  1,
+				Filter: `title:"go"`,
+			},
+			expectedAmount: 1,
+			expectedTotal:  2,
+		},
+		{
+			name: "should filter blogs by title and creation time successfully",
+			request: &pb.GetBlogsRequest{
+				Limit:  10,
+				Filter: `title:"go" AND created_at > "2000-01-01T00:00:00Z"`,
+			},
+			expectedAmount: 2,
+			expectedTotal:  2,
+		},
+		{
+			name: "should filter blogs with OR and NOT successfully",
+			request: &pb.GetBlogsRequest{
+				Limit:  10,
+				Filter: `(title:"rust" OR title:"python") AND NOT body = "other"`,
+			},
+			expectedAmount: 2,
+			expectedTotal:  2,
+		},
+		{
+			name: "should fail when filtering by unknown field",
+			request: &pb.GetBlogsRequest{
+				Filter: `author = "someone"`,
+			},
+			wantError: status.New(codes.InvalidArgument, "unknown field"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tEnv.Client.GetBlogs(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expectedAmount, len(resp.Items))
+				assert.Equal(t, tt.expectedTotal, resp.TotalItems)
+			}
+		})
+	}
+}
+
+func TestSearchBlogs(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating a new entries
+	for _, blog := range []*pb.CreateBlogRequest{
+		{Title: "error handling in go", Body: "wrapping errors"},
+		{Title: "concurrency", Body: "goroutines and channels in go"},
+		{Title: "rust ownership", Body: "borrowing rules"},
+	} {
+		_, err := tEnv.Client.CreateBlog(ctx, blog)
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name          string
+		request       *pb.SearchBlogsRequest
+		expectedTitle []string
+		wantError     *status.Status
+	}{
+		{
+			name:          "should rank title matches first",
+			request:       &pb.SearchBlogsRequest{Query: "go"},
+			expectedTitle: []string{"error handling in go", "concurrency"},
+		},
+		{
+			name:          "should exclude negated words",
+			request:       &pb.SearchBlogsRequest{Query: "rules -go"},
+			expectedTitle: []string{"rust ownership"},
+		},
+		{
+			name:          "should match stemmed words",
+			request:       &pb.SearchBlogsRequest{Query: "borrowed"},
+			expectedTitle: []string{"rust ownership"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tEnv.Client.SearchBlogs(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+				var titles []string
+				for _, result := range resp.Results {
+					titles = append(titles, result.Item.Title)
+				}
+				assert.Equal(t, tt.expectedTitle, titles)
+				assert.Equal(t, int64(len(tt.expectedTitle)), resp.TotalItems)
+			}
+		})
+	}
+
+	// highlights wrap the matching words
+	resp, err := tEnv.Client.SearchBlogs(ctx, &pb.SearchBlogsRequest{Query: "channels"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(resp.Results))
+	assert.Contains(t, resp.Results[0].BodyHighlight, "<b>channels</b>")
+}
+
 func TestDeleteBlog(t *testing.T) {
 	ctx := context.Background()
 
@@ -316,7 +798,7 @@ func TestDeleteBlog(t *testing.T) {
 	assert.NoError(t, dbErr)
 
 	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
-		bService := service.New(db, logger)
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
 		srv := New(bService, logger)
 		srv.Register(reg)
 	})
@@ -356,3 +838,115 @@ func TestDeleteBlog(t *testing.T) {
 		})
 	}
 }
+
+func TestUndeleteBlog(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating and deleting a new entry
+	resp, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{
+		Title: "title",
+		Body:  "body",
+	})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.DeleteBlog(ctx, &pb.DeleteBlogRequest{Id: resp.Id})
+	assert.NoError(t, err)
+
+	// the deleted blog is hidden from reads but listed as deleted
+	_, err = tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: resp.Id}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	blogs, err := tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), blogs.TotalItems)
+	deleted, err := tEnv.Client.ListDeletedBlogs(ctx, &pb.ListDeletedBlogsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted.TotalItems)
+	assert.Equal(t, resp.Id, deleted.Items[0].Id)
+	assert.NotNil(t, deleted.Items[0].DeletedAt)
+
+	tests := []struct {
+		name      string
+		request   *pb.UndeleteBlogRequest
+		wantError *status.Status
+	}{
+		{
+			name: "should undelete blog successfully",
+			request: &pb.UndeleteBlogRequest{
+				Id: resp.Id,
+			},
+			wantError: nil,
+		},
+		{
+			name: "should not get error when undeleting blog that is not deleted",
+			request: &pb.UndeleteBlogRequest{
+				Id: resp.Id,
+			},
+			wantError: nil,
+		},
+		{
+			name: "should fail when blog does not exist",
+			request: &pb.UndeleteBlogRequest{
+				Id: resp.Id + 1000,
+			},
+			wantError: status.New(codes.NotFound, "blog not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tEnv.Client.UndeleteBlog(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Equal(t, tt.wantError.Message(), s.Message())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+
+	got, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: resp.Id}})
+	assert.NoError(t, err)
+	assert.Nil(t, got.Item.DeletedAt)
+	deleted, err = tEnv.Client.ListDeletedBlogs(ctx, &pb.ListDeletedBlogsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), deleted.TotalItems)
+}
+
+// queryRecorder is a GORM logger recording the SQL queries that were run
+type queryRecorder struct {
+	gormlogger.Interface
+	mu      sync.Mutex
+	queries []string
+}
+
+func (r *queryRecorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	query, _ := fc()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries = append(r.queries, query)
+}
+
+func (r *queryRecorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.queries
+}
+
+func (r *queryRecorder) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries = nil
+}
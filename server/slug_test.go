@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestGetBlogBySlug(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// prepare test by creating two blogs with the same title and renaming the first
+	first, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "Café Déjà Vu", Body: "body"})
+	assert.NoError(t, err)
+	second, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "Café Déjà Vu", Body: "body"})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.UpdateBlog(ctx, &pb.UpdateBlogRequest{Id: first.Id, Title: proto.String("New Title")})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		slug      string
+		wantID    uint32
+		wantSlug  string
+		wantMoved bool
+		wantError *status.Status
+	}{
+		{
+			name:     "should get blog by slug successfully",
+			slug:     "new-title",
+			wantID:   first.Id,
+			wantSlug: "new-title",
+		},
+		{
+			name:     "should get blog by slug with a collision suffix successfully",
+			slug:     "cafe-deja-vu-2",
+			wantID:   second.Id,
+			wantSlug: "cafe-deja-vu-2",
+		},
+		{
+			name:      "should get blog by former slug and report it moved",
+			slug:      "cafe-deja-vu",
+			wantID:    first.Id,
+			wantSlug:  "new-title",
+			wantMoved: true,
+		},
+		{
+			name:      "should fail when slug does not exist",
+			slug:      "unknown",
+			wantError: status.New(codes.NotFound, "blog not found"),
+		},
+		{
+			name:      "should fail when slug is invalid",
+			slug:      "Not A Slug",
+			wantError: status.New(codes.InvalidArgument, "slug"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Slug{Slug: tt.slug}})
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.wantID, resp.Item.Id)
+				assert.Equal(t, tt.wantSlug, resp.Item.Slug)
+				assert.Equal(t, tt.wantMoved, resp.Moved)
+			}
+		})
+	}
+
+	// a new blog cannot take the former slug of another blog
+	third, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "Cafe deja vu", Body: "body"})
+	assert.NoError(t, err)
+	got, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: third.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, "cafe-deja-vu-3", got.Item.Slug)
+}
+
+func TestCreateBlogsWithTheSameTitleConcurrently(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		New(service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret)), logger).Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// every blog gets its own slug instead of failing on the unique index
+	const count = 10
+	ids := make(chan uint32, count)
+	var wg sync.WaitGroup
+	for range count {
+		wg.Go(func() {
+			resp, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "Same Title", Body: "body"})
+			if assert.NoError(t, err) {
+				ids <- resp.Id
+			}
+		})
+	}
+	wg.Wait()
+	close(ids)
+
+	var slugs []string
+	for id := range ids {
+		got, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: id}})
+		assert.NoError(t, err)
+		slugs = append(slugs, got.Item.Slug)
+	}
+	want := []string{"same-title"}
+	for n := 2; n <= count; n++ {
+		want = append(want, "same-title-"+strconv.Itoa(n))
+	}
+	assert.ElementsMatch(t, want, slugs)
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Server) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	pagination := service.Pagination{
+		Limit: int(req.GetLimit()),
+		Page:  int(req.GetPage()),
+	}
+	sRes, err := s.service.GetTags(ctx, &pagination)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.ListTagsResponse
+	for _, tag := range sRes.Items.([]service.TagCount) {
+		res.Items = append(res.Items, &pb.Tag{
+			Name:      tag.Name,
+			BlogCount: tag.BlogCount,
+		})
+	}
+	res.Limit = int32(sRes.Limit)
+	res.Page = int32(sRes.Page)
+	res.TotalItems = sRes.TotalItems
+	res.TotalPages = int32(sRes.TotalPages)
+	return &res, nil
+}
+
+func (s *Server) RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*emptypb.Empty, error) {
+	err := s.service.RenameTag(ctx, req.GetName(), req.GetNewName())
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) MergeTags(ctx context.Context, req *pb.MergeTagsRequest) (*emptypb.Empty, error) {
+	err := s.service.MergeTags(ctx, req.GetSources(), req.GetTarget())
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
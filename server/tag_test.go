@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestBlogTags(t *testing.T) {
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// tags are normalized and sorted
+	first, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "first", Body: "body", Tags: []string{"Go", "Web Development", "go"}})
+	assert.NoError(t, err)
+	second, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "second", Body: "body", Tags: []string{"golang"}})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "draft", Body: "body", Tags: []string{"go"}, Status: pb.BlogStatus_BLOG_STATUS_DRAFT})
+	assert.NoError(t, err)
+	got, err := tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: first.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"go", "web-development"}, got.Item.Tags)
+
+	_, err = tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "invalid", Body: "body", Tags: []string{"go/rust"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// only published blogs are counted
+	tags, err := tEnv.Client.ListTags(ctx, &pb.ListTagsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), tags.TotalItems)
+	assert.Equal(t, map[string]int64{"go": 1, "golang": 1, "web-development": 1}, tagCounts(tags.Items))
+
+	blogs, err := tEnv.Client.GetBlogs(ctx, &pb.GetBlogsRequest{Tag: "Web Development"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), blogs.TotalItems)
+	assert.Equal(t, first.Id, blogs.Items[0].Id)
+
+	// tags are replaced when present, and cleared with an update mask
+	_, err = tEnv.Client.UpdateBlog(ctx, &pb.UpdateBlogRequest{Id: first.Id, Tags: []string{"go", "grpc"}})
+	assert.NoError(t, err)
+	got, err = tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: first.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"go", "grpc"}, got.Item.Tags)
+	_, err = tEnv.Client.UpdateBlog(ctx, &pb.UpdateBlogRequest{Id: second.Id, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}}})
+	assert.NoError(t, err)
+	got, err = tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: second.Id}})
+	assert.NoError(t, err)
+	assert.Empty(t, got.Item.Tags)
+	_, err = tEnv.Client.UpdateBlog(ctx, &pb.UpdateBlogRequest{Id: second.Id, Tags: []string{"golang"}})
+	assert.NoError(t, err)
+
+	renameTests := []struct {
+		name      string
+		request   *pb.RenameTagRequest
+		wantError *status.Status
+	}{
+		{
+			name:      "should fail when tag does not exist",
+			request:   &pb.RenameTagRequest{Name: "unknown", NewName: "other"},
+			wantError: status.New(codes.NotFound, `tag "unknown" not found`),
+		},
+		{
+			name:      "should fail when new name is taken",
+			request:   &pb.RenameTagRequest{Name: "grpc", NewName: "go"},
+			wantError: status.New(codes.AlreadyExists, `tag "go" already exists, merge the tags instead`),
+		},
+		{
+			name:    "should rename tag successfully",
+			request: &pb.RenameTagRequest{Name: "grpc", NewName: "Protocol Buffers"},
+		},
+	}
+	for _, tt := range renameTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tEnv.Client.RenameTag(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Equal(t, tt.wantError.Message(), s.Message())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+	got, err = tEnv.Client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: first.Id}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"go", "protocol-buffers"}, got.Item.Tags)
+
+	mergeTests := []struct {
+		name      string
+		request   *pb.MergeTagsRequest
+		wantError *status.Status
+	}{
+		{
+			name:      "should fail when a source tag does not exist",
+			request:   &pb.MergeTagsRequest{Sources: []string{"golang", "unknown"}, Target: "go"},
+			wantError: status.New(codes.NotFound, `tag "unknown" not found`),
+		},
+		{
+			name:    "should merge tags successfully",
+			request: &pb.MergeTagsRequest{Sources: []string{"golang", "go"}, Target: "go"},
+		},
+	}
+	for _, tt := range mergeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tEnv.Client.MergeTags(ctx, tt.request)
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Equal(t, tt.wantError.Message(), s.Message())
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+	tags, err = tEnv.Client.ListTags(ctx, &pb.ListTagsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"go": 2, "protocol-buffers": 1, "web-development": 0}, tagCounts(tags.Items))
+	assert.Equal(t, "go", tags.Items[0].Name)
+}
+
+// tagCounts returns the blog count of every tag by name
+func tagCounts(tags []*pb.Tag) map[string]int64 {
+	counts := map[string]int64{}
+	for _, tag := range tags {
+		counts[tag.Name] = tag.BlogCount
+	}
+	return counts
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/susana-garcia/go-crud/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// dateLayout is the layout of the dates of the view stats
+const dateLayout = "2006-01-02"
+
+func (s *Server) RecordView(ctx context.Context, req *pb.RecordViewRequest) (*emptypb.Empty, error) {
+	err := s.service.RecordView(ctx, uint(req.GetBlogId()))
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) GetBlogViewStats(ctx context.Context, req *pb.GetBlogViewStatsRequest) (*pb.GetBlogViewStatsResponse, error) {
+	from, err := parseDate("from", req.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseDate("to", req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+	views, err := s.service.GetBlogViewStats(ctx, uint(req.GetBlogId()), from, to)
+	if err != nil {
+		s.logger.Error("got service error ", "error", err)
+		return nil, err
+	}
+	var res pb.GetBlogViewStatsResponse
+	for _, view := range views {
+		res.Days = append(res.Days, &pb.DailyViews{
+			Date:  view.Day.Format(dateLayout),
+			Views: view.Views,
+		})
+		res.TotalViews += view.Views
+	}
+	return &res, nil
+}
+
+// parseDate parses a YYYY-MM-DD date of the request, empty dates are zero
+func parseDate(field string, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s must be a valid YYYY-MM-DD date", field)
+	}
+	return date, nil
+}
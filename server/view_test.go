@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestBlogViews(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret), service.WithViewFlushInterval(50*time.Millisecond))
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		New(bService, logger).Register(reg)
+	})
+	defer tEnv.Cancel()
+	client := tEnv.Client
+
+	// prepare test by creating a blog
+	blog, err := client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "blog", Body: "body"})
+	assert.NoError(t, err)
+
+	// views are recorded explicitly or when reading the blog with count_view
+	for range 2 {
+		_, err = client.RecordView(ctx, &pb.RecordViewRequest{BlogId: blog.Id})
+		assert.NoError(t, err)
+	}
+	_, err = client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: blog.Id}, CountView: true})
+	assert.NoError(t, err)
+	_, err = client.GetBlog(ctx, &pb.GetBlogRequest{Value: &pb.GetBlogRequest_Id{Id: blog.Id}})
+	assert.NoError(t, err)
+
+	// buffered views are returned before they are flushed
+	today := time.Now().UTC().Format("2006-01-02")
+	stats, err := client.GetBlogViewStats(ctx, &pb.GetBlogViewStatsRequest{BlogId: blog.Id})
+	assert.NoError(t, err)
+	assert.Len(t, stats.Days, 30)
+	assert.Equal(t, int64(3), stats.TotalViews)
+	assert.Equal(t, today, stats.Days[29].Date)
+	assert.Equal(t, int64(3), stats.Days[29].Views)
+
+	// views are flushed into the daily views in the background
+	go bService.Run(ctx)
+	assert.Eventually(t, func() bool {
+		var views int64
+		err := db.Model(&service.BlogView{}).Where("blog_id = ?", blog.Id).Select("COALESCE(SUM(views), 0)").Scan(&views).Error
+		return err == nil && views == 3
+	}, 5*time.Second, 50*time.Millisecond)
+
+	stats, err = client.GetBlogViewStats(ctx, &pb.GetBlogViewStatsRequest{BlogId: blog.Id, From: today, To: today})
+	assert.NoError(t, err)
+	assert.Len(t, stats.Days, 1)
+	assert.Equal(t, int64(3), stats.TotalViews)
+
+	errorTests := []struct {
+		name      string
+		call      func() error
+		wantError *status.Status
+	}{
+		{
+			name: "should fail to record a view of a blog that does not exist",
+			call: func() error {
+				_, err := client.RecordView(ctx, &pb.RecordViewRequest{BlogId: blog.Id + 1000})
+				return err
+			},
+			wantError: status.New(codes.NotFound, "blog not found"),
+		},
+		{
+			name: "should fail when from is after to",
+			call: func() error {
+				_, err := client.GetBlogViewStats(ctx, &pb.GetBlogViewStatsRequest{BlogId: blog.Id, From: "2024-02-01", To: "2024-01-01"})
+				return err
+			},
+			wantError: status.New(codes.InvalidArgument, "from must not be after to"),
+		},
+		{
+			name: "should fail when the range is too long",
+			call: func() error {
+				_, err := client.GetBlogViewStats(ctx, &pb.GetBlogViewStatsRequest{BlogId: blog.Id, From: "2023-01-01", To: "2024-12-31"})
+				return err
+			},
+			wantError: status.New(codes.InvalidArgument, "date range must be at most 366 days"),
+		},
+		{
+			name: "should fail when a date is not valid",
+			call: func() error {
+				_, err := client.GetBlogViewStats(ctx, &pb.GetBlogViewStatsRequest{BlogId: blog.Id, From: "2024-13-01"})
+				return err
+			},
+			wantError: status.New(codes.InvalidArgument, "from must be a valid YYYY-MM-DD date"),
+		},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ok := status.FromError(tt.call())
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantError.Code(), s.Code())
+			assert.Equal(t, tt.wantError.Message(), s.Message())
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	changeTypes = map[service.ChangeType]pb.ChangeType{
+		service.ChangeCreated: pb.ChangeType_CHANGE_TYPE_CREATED,
+		service.ChangeUpdated: pb.ChangeType_CHANGE_TYPE_UPDATED,
+		service.ChangeDeleted: pb.ChangeType_CHANGE_TYPE_DELETED,
+	}
+	pbChangeTypes = map[pb.ChangeType]service.ChangeType{
+		pb.ChangeType_CHANGE_TYPE_CREATED: service.ChangeCreated,
+		pb.ChangeType_CHANGE_TYPE_UPDATED: service.ChangeUpdated,
+		pb.ChangeType_CHANGE_TYPE_DELETED: service.ChangeDeleted,
+	}
+)
+
+func (s *Server) WatchBlogs(req *pb.WatchBlogsRequest, stream grpc.ServerStreamingServer[pb.BlogChange]) error {
+	var types []service.ChangeType
+	for _, t := range req.GetTypes() {
+		types = append(types, pbChangeTypes[t])
+	}
+	err := s.service.WatchBlogs(stream.Context(), req.GetAfterSequence(), req.GetFilter(), types, func(change service.BlogChange) error {
+		blog, err := change.Blog()
+		if err != nil {
+			return err
+		}
+		return stream.Send(&pb.BlogChange{
+			Sequence:  change.Sequence,
+			Type:      changeTypes[change.Type],
+			Item:      toPBBlog(blog),
+			ChangedAt: timestamppb.New(change.CreatedAt),
+		})
+	})
+	if err != nil && stream.Context().Err() == nil {
+		s.logger.Error("got service error ", "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/susana-garcia/go-crud/pb"
+	"github.com/susana-garcia/go-crud/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	crudtesting "github.com/susana-garcia/go-crud/internal/testing"
+)
+
+func TestWatchBlogs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, dbErr := crudtesting.SetupDatabase(logger)
+	assert.NoError(t, dbErr)
+	dbErr = crudtesting.CleanUpDatabaseEntries(db, logger)
+	assert.NoError(t, dbErr)
+
+	tEnv := crudtesting.NewTestEnvWithRegistration(ctx, t, func(reg grpc.ServiceRegistrar) {
+		bService := service.New(db, logger, service.WithPageTokenSecret(crudtesting.PageTokenSecret))
+		go bService.Run(ctx)
+		srv := New(bService, logger)
+		srv.Register(reg)
+	})
+	defer tEnv.Cancel()
+
+	// give the listener time to start listening for changes
+	time.Sleep(500 * time.Millisecond)
+
+	stream, err := tEnv.Client.WatchBlogs(ctx, &pb.WatchBlogsRequest{Filter: `title:"go"`})
+	assert.NoError(t, err)
+
+	// a long running transaction that does not change blogs must not hold the changes back
+	longTx := db.Begin()
+	assert.NoError(t, longTx.Exec("SELECT pg_current_xact_id()").Error)
+	defer longTx.Rollback()
+
+	// prepare test by changing blogs, only the ones about go are watched
+	created, err := tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "go title", Body: "body"})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.CreateBlog(ctx, &pb.CreateBlogRequest{Title: "rust title", Body: "body"})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.UpdateBlog(ctx, &pb.UpdateBlogRequest{Id: created.Id, Body: proto.String("new body")})
+	assert.NoError(t, err)
+	_, err = tEnv.Client.DeleteBlog(ctx, &pb.DeleteBlogRequest{Id: created.Id})
+	assert.NoError(t, err)
+
+	var changes []*pb.BlogChange
+	for range 3 {
+		change, err := stream.Recv()
+		assert.NoError(t, err)
+		changes = append(changes, change)
+	}
+	assert.Equal(t, pb.ChangeType_CHANGE_TYPE_CREATED, changes[0].Type)
+	assert.Equal(t, pb.ChangeType_CHANGE_TYPE_UPDATED, changes[1].Type)
+	assert.Equal(t, "new body", changes[1].Item.Body)
+	assert.Equal(t, pb.ChangeType_CHANGE_TYPE_DELETED, changes[2].Type)
+	for i, change := range changes {
+		assert.Equal(t, created.Id, change.Item.Id)
+		if i > 0 {
+			assert.Greater(t, change.Sequence, changes[i-1].Sequence)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		request      *pb.WatchBlogsRequest
+		expectedType pb.ChangeType
+		wantError    *status.Status
+	}{
+		{
+			name: "should resume after a sequence successfully",
+			request: &pb.WatchBlogsRequest{
+				AfterSequence: changes[0].Sequence,
+				Types:         []pb.ChangeType{pb.ChangeType_CHANGE_TYPE_DELETED},
+			},
+			expectedType: pb.ChangeType_CHANGE_TYPE_DELETED,
+		},
+		{
+			name: "should fail when the changes after the sequence are no longer available",
+			request: &pb.WatchBlogsRequest{
+				AfterSequence: changes[0].Sequence - 1,
+			},
+			wantError: status.New(codes.OutOfRange, "no longer available"),
+		},
+		{
+			name: "should fail when filter is invalid",
+			request: &pb.WatchBlogsRequest{
+				Filter: `unknown = "x"`,
+			},
+			wantError: status.New(codes.InvalidArgument, "unknown field"),
+		},
+		{
+			name: "should fail when type is unspecified",
+			request: &pb.WatchBlogsRequest{
+				Types: []pb.ChangeType{pb.ChangeType_CHANGE_TYPE_UNSPECIFIED},
+			},
+			wantError: status.New(codes.InvalidArgument, "validation failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := tEnv.Client.WatchBlogs(ctx, tt.request)
+			assert.NoError(t, err)
+			change, err := stream.Recv()
+			if tt.wantError != nil {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantError.Code(), s.Code())
+				assert.Contains(t, s.Message(), tt.wantError.Message())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expectedType, change.Type)
+				assert.Equal(t, created.Id, change.Item.Id)
+			}
+		})
+	}
+}
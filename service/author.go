@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// Author writes blogs
+type Author struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Handle    string    `gorm:"not null;size:30;uniqueIndex" json:"handle"`
+	Name      string    `gorm:"not null;size:100" json:"name"`
+	Bio       string    `gorm:"type:text" json:"bio"`
+	AvatarURL string    `gorm:"size:2048" json:"avatar_url"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName specifies the table name for the Author model
+func (Author) TableName() string {
+	return "authors"
+}
+
+// updatableAuthorFields maps the field names accepted by UpdateAuthor to their columns
+var updatableAuthorFields = map[string]string{
+	"handle":     "handle",
+	"name":       "name",
+	"bio":        "bio",
+	"avatar_url": "avatar_url",
+}
+
+// fieldValue returns the value of the given column in the author
+func (a Author) fieldValue(column string) any {
+	switch column {
+	case "handle":
+		return a.Handle
+	case "name":
+		return a.Name
+	case "bio":
+		return a.Bio
+	case "avatar_url":
+		return a.AvatarURL
+	}
+	return nil
+}
+
+// authorFilter restricts the filter to the blogs of the author, it returns the
+// filter unchanged when authorID is 0
+func authorFilter(filter filterNode, authorID uint) filterNode {
+	if authorID == 0 {
+		return filter
+	}
+	if filter == nil {
+		return authorNode{id: authorID}
+	}
+	return andNode{children: []filterNode{authorNode{id: authorID}, filter}}
+}
+
+// authorNode matches the blogs of the author
+type authorNode struct {
+	id uint
+}
+
+func (n authorNode) sql() (string, []any) {
+	return "author_id = ?", []any{n.id}
+}
+
+func (n authorNode) matches(blog Blog) bool {
+	return blog.AuthorID != nil && *blog.AuthorID == n.id
+}
+
+// authorError reports writes of blogs referencing an author that does not exist
+// as InvalidArgument
+func authorError(err error) error {
+	if errors.Is(err, gorm.ErrForeignKeyViolated) {
+		return status.Error(codes.InvalidArgument, "author not found")
+	}
+	return err
+}
+
+// handleError reports a handle used by another author as AlreadyExists
+func handleError(err error, handle string) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return status.Errorf(codes.AlreadyExists, "handle %q is already taken", handle)
+	}
+	return err
+}
+
+// GetAuthors returns a page of the authors sorted by handle
+func (s *Service) GetAuthors(ctx context.Context, pagination *Pagination) (*Pagination, error) {
+	var totalItems int64
+	if err := s.db.WithContext(ctx).Model(&Author{}).Count(&totalItems).Error; err != nil {
+		s.logger.Error("unable to count authors", "error", err)
+		return nil, err
+	}
+	pagination.TotalItems = totalItems
+	pagination.TotalPages = int(math.Ceil(float64(totalItems) / float64(pagination.GetLimit())))
+
+	authors, err := gorm.G[Author](s.db).Order("handle").Offset(pagination.GetOffset()).Limit(pagination.GetLimit()).Find(ctx)
+	if err != nil {
+		s.logger.Error("unable to get authors", "error", err)
+		return nil, err
+	}
+	s.logger.Info(fmt.Sprintf("found %d authors", len(authors)))
+	pagination.Items = authors
+	return pagination, nil
+}
+
+// GetAuthorByIDOrHandle returns the author with the id, or with the handle when id is 0
+func (s *Service) GetAuthorByIDOrHandle(ctx context.Context, id uint, handle string) (*Author, error) {
+	query := gorm.G[Author](s.db)
+	var author Author
+	var err error
+	if id > 0 {
+		author, err = query.Where("id = ?", id).First(ctx)
+	} else {
+		author, err = query.Where("handle = ?", handle).First(ctx)
+	}
+	if err != nil {
+		s.logger.Error("unable to get author", "id", id, "handle", handle, "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "author not found")
+		}
+		return nil, err
+	}
+	return &author, nil
+}
+
+func (s *Service) CreateAuthor(ctx context.Context, author Author) (uint, error) {
+	err := gorm.G[Author](s.db).Create(ctx, &author)
+	if err != nil {
+		s.logger.Error("unable to create author", "handle", author.Handle, "error", err)
+		return 0, handleError(err, author.Handle)
+	}
+	return author.ID, nil
+}
+
+// UpdateAuthor updates exactly the given fields of the author, including zero values
+func (s *Service) UpdateAuthor(ctx context.Context, author Author, fields []string) error {
+	if len(fields) == 0 {
+		return status.Error(codes.InvalidArgument, "no fields to update")
+	}
+	values := map[string]any{}
+	for _, field := range fields {
+		column, ok := updatableAuthorFields[field]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown field %q", field)
+		}
+		values[column] = author.fieldValue(column)
+	}
+	result := s.db.WithContext(ctx).Model(&Author{}).Where("id = ?", author.ID).Updates(values)
+	if result.Error != nil {
+		s.logger.Error("unable to update author", "id", author.ID, "error", result.Error)
+		return handleError(result.Error, author.Handle)
+	}
+	if result.RowsAffected == 0 {
+		return status.Error(codes.NotFound, "author not found")
+	}
+	s.logger.Info("updated author", "id", author.ID)
+	return nil
+}
+
+// DeleteAuthor deletes the author. Its blogs, including the deleted ones, are
+// kept without an author and get a new version.
+func (s *Service) DeleteAuthor(ctx context.Context, id uint) error {
+	var blogs int64
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Blog{}).Unscoped().Where("author_id = ?", id).Updates(map[string]any{
+			"author_id": nil,
+			"version":   gorm.Expr("version + 1"),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		blogs = result.RowsAffected
+		return tx.Delete(&Author{}, id).Error
+	})
+	if err != nil {
+		s.logger.Error("unable to delete author", "id", id, "error", err)
+		return err
+	}
+	s.logger.Info("deleted author", "id", id, "blogs", blogs)
+	return nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// BatchResult is the outcome of one item of a batch
+type BatchResult struct {
+	ID  uint
+	Err error
+}
+
+// errBatchFailed rolls back an all or nothing batch after an item failed
+var errBatchFailed = errors.New("batch failed")
+
+// GetBlogsByIDs returns the blogs with the given ids that exist, in one query
+func (s *Service) GetBlogsByIDs(ctx context.Context, ids []uint) ([]Blog, error) {
+	blogs, err := blogsWithRelations(s.db).Where("id IN ?", ids).Find(ctx)
+	if err != nil {
+		s.logger.Error("unable to get blogs", "ids", ids, "error", err)
+		return nil, err
+	}
+	s.logger.Info("found", "blogs", len(blogs), "requested", len(ids))
+	return blogs, nil
+}
+
+// CreateBlogs creates the blogs in one transaction. When atomic, no blog is
+// created if any of them fails, otherwise every blog is created in its own
+// savepoint and the failures are reported in the results.
+func (s *Service) CreateBlogs(ctx context.Context, blogs []Blog, atomic bool) ([]BatchResult, error) {
+	results := make([]BatchResult, len(blogs))
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range blogs {
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				return authorError(tx.Create(&blogs[i]).Error)
+			})
+			results[i] = BatchResult{ID: blogs[i].ID, Err: err}
+			if err != nil {
+				s.logger.Error("unable to create blog", "index", i, "error", err)
+				if atomic {
+					return errBatchFailed
+				}
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchFailed) {
+		return results, batchError(results)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("created", "blogs", len(blogs))
+	return results, nil
+}
+
+// DeleteBlogs deletes the blogs in one transaction. When atomic, no blog is
+// deleted if any of them fails or does not exist, otherwise the failures are
+// reported in the results.
+func (s *Service) DeleteBlogs(ctx context.Context, ids []uint, atomic bool) ([]BatchResult, error) {
+	results := make([]BatchResult, len(ids))
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			var rows int
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				var err error
+				rows, err = gorm.G[Blog](tx).Where("id = ?", id).Delete(ctx)
+				return err
+			})
+			if err == nil && rows == 0 {
+				err = status.Error(codes.NotFound, "blog not found")
+			}
+			results[i] = BatchResult{ID: id, Err: err}
+			if err != nil {
+				s.logger.Error("unable to delete blog", "id", id, "error", err)
+				if atomic {
+					return errBatchFailed
+				}
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchFailed) {
+		return results, batchError(results)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("deleted", "blogs", len(ids))
+	return results, nil
+}
+
+// batchError returns the error of the first failed item of an atomic batch
+func batchError(results []BatchResult) error {
+	for i, result := range results {
+		if result.Err != nil {
+			code := status.Code(result.Err)
+			if code == codes.Unknown {
+				code = codes.Aborted
+			}
+			return status.Errorf(code, "item %d failed, no changes were made: %v", i, result.Err)
+		}
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -13,23 +15,98 @@ import (
 )
 
 type Service struct {
-	db     *gorm.DB
-	logger *slog.Logger
+	db                *gorm.DB
+	logger            *slog.Logger
+	idempotencyKeyTTL time.Duration
+	pageTokenSecret   []byte
+	searchLanguage    string
+	watchBufferSize   int
+	changeRetention   time.Duration
+	deletedRetention  time.Duration
+	publishInterval   time.Duration
+	viewFlushInterval time.Duration
+	changes           *changeHub
+	views             *viewBuffer
 }
 
-func New(db *gorm.DB, logger *slog.Logger) *Service {
-	return &Service{
-		db:     db,
-		logger: logger,
+// Option configures optional behaviour of the Service
+type Option func(*Service)
+
+// WithPageTokenSecret sets the secret used to sign page tokens. It is required
+// to page with page tokens, and must be the same on every instance so that the
+// tokens stay valid across instances and restarts.
+func WithPageTokenSecret(secret []byte) Option {
+	return func(s *Service) {
+		s.pageTokenSecret = secret
+	}
+}
+
+// WithIdempotencyKeyTTL sets how long idempotency keys are kept
+func WithIdempotencyKeyTTL(ttl time.Duration) Option {
+	return func(s *Service) {
+		s.idempotencyKeyTTL = ttl
 	}
 }
 
+func New(db *gorm.DB, logger *slog.Logger, opts ...Option) *Service {
+	s := &Service{
+		db:                db,
+		logger:            logger,
+		idempotencyKeyTTL: DefaultIdempotencyKeyTTL,
+		searchLanguage:    DefaultSearchLanguage,
+		watchBufferSize:   DefaultWatchBufferSize,
+		changeRetention:   DefaultChangeRetention,
+		deletedRetention:  DefaultDeletedRetention,
+		publishInterval:   DefaultPublishInterval,
+		viewFlushInterval: DefaultViewFlushInterval,
+		changes:           newChangeHub(),
+		views:             newViewBuffer(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if err := registerEditorCallbacks(db); err != nil {
+		logger.Error("unable to register editor callbacks, revisions will have no editor", "error", err)
+	}
+	return s
+}
+
 type Blogger interface {
 	GetAllBlogs(ctx context.Context, pagination *Pagination) (*Pagination, error)
 	GetBlogByIDOrTitle(ctx context.Context, id uint, title string) (*Blog, error)
-	CreateBlog(ctx context.Context, blog Blog) (uint, error)
-	UpdateBlog(ctx context.Context, blog Blog) error
-	DeleteBlog(ctx context.Context, id uint) error
+	SearchBlogs(ctx context.Context, query string, pagination *Pagination) (*Pagination, error)
+	CreateBlog(ctx context.Context, blog Blog, idempotencyKey string) (uint, error)
+	UpdateBlog(ctx context.Context, blog Blog, fields []string, etag string) error
+	DeleteBlog(ctx context.Context, id uint, etag string) error
+	GetBlogsByIDs(ctx context.Context, ids []uint) ([]Blog, error)
+	CreateBlogs(ctx context.Context, blogs []Blog, atomic bool) ([]BatchResult, error)
+	DeleteBlogs(ctx context.Context, ids []uint, atomic bool) ([]BatchResult, error)
+	WatchBlogs(ctx context.Context, after uint64, filter string, types []ChangeType, send func(BlogChange) error) error
+	ImportBlogs(ctx context.Context, blogs []Blog) ([]ImportResult, error)
+	ExportBlogs(ctx context.Context, filter string, send func(Blog) error) error
+	UndeleteBlog(ctx context.Context, id uint) error
+	GetDeletedBlogs(ctx context.Context, pagination *Pagination) (*Pagination, error)
+	GetBlogRevisions(ctx context.Context, blogID uint, pagination *Pagination) (*Pagination, error)
+	GetBlogRevision(ctx context.Context, blogID uint, number uint) (*BlogRevision, error)
+	RestoreBlogRevision(ctx context.Context, blogID uint, number uint, etag string) error
+	DiffBlogRevisions(ctx context.Context, blogID uint, from uint, to uint) (title []DiffLine, body []DiffLine, err error)
+	PublishBlog(ctx context.Context, id uint, publishAt time.Time, etag string) error
+	UnpublishBlog(ctx context.Context, id uint, archive bool, etag string) error
+	GetBlogBySlug(ctx context.Context, slug string) (*Blog, bool, error)
+	GetTags(ctx context.Context, pagination *Pagination) (*Pagination, error)
+	RenameTag(ctx context.Context, name string, newName string) error
+	MergeTags(ctx context.Context, sources []string, target string) error
+	GetAuthors(ctx context.Context, pagination *Pagination) (*Pagination, error)
+	GetAuthorByIDOrHandle(ctx context.Context, id uint, handle string) (*Author, error)
+	CreateAuthor(ctx context.Context, author Author) (uint, error)
+	UpdateAuthor(ctx context.Context, author Author, fields []string) error
+	DeleteAuthor(ctx context.Context, id uint) error
+	AddReaction(ctx context.Context, reaction Reaction) error
+	RemoveReaction(ctx context.Context, reaction Reaction) error
+	GetReactions(ctx context.Context, blogID uint, reactionType ReactionType, pagination *Pagination) (*Pagination, error)
+	RecordView(ctx context.Context, id uint) error
+	CountView(blog *Blog)
+	GetBlogViewStats(ctx context.Context, blogID uint, from time.Time, to time.Time) ([]BlogView, error)
 }
 
 type Blog struct {
@@ -38,6 +115,47 @@ type Blog struct {
 	Body      string    `gorm:"type:text" json:"body"`
 	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	Version   uint      `gorm:"not null;default:1" json:"version"`
+	// SourceID identifies an imported blog in the source it was imported from
+	SourceID *string `gorm:"uniqueIndex;size:255" json:"source_id,omitempty"`
+	// DeletedAt is set when the blog is deleted, deleted blogs are excluded
+	// from queries until they are undeleted or purged
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+	Status    BlogStatus     `gorm:"not null;size:16;default:published;index" json:"status"`
+	// PublishAt is when the blog was or is scheduled to be published
+	PublishAt *time.Time `gorm:"index" json:"publish_at"`
+	// Slug is the unique URL safe name of the blog, generated from its title
+	Slug string `gorm:"size:80;uniqueIndex" json:"slug"`
+	// Tags are loaded sorted by name, the associations are deleted with the blog
+	Tags []Tag `gorm:"many2many:blog_tags;constraint:OnDelete:CASCADE" json:"tags"`
+	// AuthorID is nil for blogs without an author
+	AuthorID *uint   `gorm:"index" json:"author_id,omitempty"`
+	Author   *Author `gorm:"constraint:OnDelete:SET NULL" json:"-"`
+	// ReactionCounts are loaded sorted by type, without the types nobody reacted with
+	ReactionCounts []ReactionCount `gorm:"foreignKey:BlogID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// errNotUpdated rolls back an update that matched no rows
+var errNotUpdated = errors.New("blog not updated")
+
+// updatableFields maps the field names accepted by UpdateBlog to their columns
+var updatableFields = map[string]string{
+	"title":     "title",
+	"body":      "body",
+	"author_id": "author_id",
+}
+
+// fieldValue returns the value of the given column in the blog
+func (b Blog) fieldValue(column string) any {
+	switch column {
+	case "title":
+		return b.Title
+	case "body":
+		return b.Body
+	case "author_id":
+		return b.AuthorID
+	}
+	return nil
 }
 
 // TableName specifies the table name for the Blog model
@@ -45,20 +163,170 @@ func (Blog) TableName() string {
 	return "blogs"
 }
 
+// ETag returns an opaque tag identifying the current version of the blog
+func (b Blog) ETag() string {
+	return strconv.FormatUint(uint64(b.Version), 10)
+}
+
+// parseETag returns the version encoded in the etag, 0 when etag is empty
+func parseETag(etag string) (uint, error) {
+	if etag == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseUint(etag, 10, 0)
+	if err != nil || version == 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid etag %q", etag)
+	}
+	return uint(version), nil
+}
+
+// checkVersion is called when a write matched no rows, and tells apart a blog
+// that does not exist from one that was modified since the etag was read
+func (s *Service) checkVersion(ctx context.Context, id uint, version uint) error {
+	count, err := gorm.G[Blog](s.db).Where("id = ?", id).Count(ctx, "id")
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return status.Error(codes.NotFound, "blog not found")
+	}
+	if version > 0 {
+		return status.Error(codes.Aborted, "blog was modified, etag does not match")
+	}
+	return nil
+}
+
+// GetAllBlogs returns a page of blogs. Pages are selected by page number, or
+// by the page token returned with the previous page.
 func (s *Service) GetAllBlogs(ctx context.Context, pagination *Pagination) (*Pagination, error) {
+	if pagination.PageToken != "" {
+		return s.getBlogsAfterToken(ctx, pagination)
+	}
+	keys, err := parseSort(pagination.Sort)
+	if err != nil {
+		return nil, err
+	}
+	pagination.Sort = orderClause(keys)
+	filter, err := parseFilter(pagination.Filter)
+	if err != nil {
+		return nil, err
+	}
+	pagination.Statuses = normalizeStatuses(pagination.Statuses)
+	filter = statusFilter(filter, pagination.Statuses)
+	if pagination.Tag, err = normalizeOptionalTag(pagination.Tag); err != nil {
+		return nil, err
+	}
+	filter = authorFilter(tagFilter(filter, pagination.Tag), pagination.AuthorID)
+
 	var blogs []Blog
-	result := s.db.Scopes(paginate(blogs, pagination, s.db)).Find(&blogs)
+	page, err := paginate(blogs, pagination, keys, filter, s.db.WithContext(ctx))
+	if err != nil {
+		s.logger.Error("unable to count blogs", "error", err)
+		return nil, err
+	}
+	result := s.db.WithContext(ctx).Scopes(preloadBlogRelations, filterScope(filter), page).Find(&blogs)
 	s.logger.Info(fmt.Sprintf("found %d blogs", result.RowsAffected))
 	if result.Error != nil {
 		s.logger.Error("unable to get all blogs", "error", result.Error)
 		return nil, result.Error
 	}
 	pagination.Items = blogs
+
+	// let the caller continue with a page token when there are more pages
+	if pagination.Page < pagination.TotalPages && len(blogs) > 0 {
+		pagination.NextPageToken, err = encodePageToken(cursor{
+			Sort:     pagination.Sort,
+			Filter:   pagination.Filter,
+			Statuses: pagination.Statuses,
+			Tag:      pagination.Tag,
+			AuthorID: pagination.AuthorID,
+			Values:   cursorValues(keys, blogs[len(blogs)-1]),
+		}, s.pageTokenSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return pagination, result.Error
 }
 
+// getBlogsAfterToken returns the page of blogs following the position stored
+// in the page token, using a keyset query instead of an offset
+func (s *Service) getBlogsAfterToken(ctx context.Context, pagination *Pagination) (*Pagination, error) {
+	c, err := decodePageToken(pagination.PageToken, s.pageTokenSecret)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseSort(c.Sort)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+	if pagination.Sort != "" {
+		requested, err := parseSort(pagination.Sort)
+		if err != nil {
+			return nil, err
+		}
+		if orderClause(requested) != c.Sort {
+			return nil, status.Error(codes.InvalidArgument, "sort must not change between pages")
+		}
+	}
+	if pagination.Filter != "" && pagination.Filter != c.Filter {
+		return nil, status.Error(codes.InvalidArgument, "filter must not change between pages")
+	}
+	if len(pagination.Statuses) > 0 && !slices.Equal(normalizeStatuses(pagination.Statuses), c.Statuses) {
+		return nil, status.Error(codes.InvalidArgument, "statuses must not change between pages")
+	}
+	if tag, err := normalizeOptionalTag(pagination.Tag); err != nil || tag != "" && tag != c.Tag {
+		return nil, status.Error(codes.InvalidArgument, "tag must not change between pages")
+	}
+	if pagination.AuthorID != 0 && pagination.AuthorID != c.AuthorID {
+		return nil, status.Error(codes.InvalidArgument, "author_id must not change between pages")
+	}
+	filter, err := parseFilter(c.Filter)
+	if err != nil {
+		return nil, err
+	}
+	filter = authorFilter(tagFilter(statusFilter(filter, normalizeStatuses(c.Statuses)), c.Tag), c.AuthorID)
+	values, err := decodeCursorValues(keys, c.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	// fetch one more blog than requested to know whether there is a next page
+	limit := pagination.GetLimit()
+	var blogs []Blog
+	result := s.db.WithContext(ctx).Scopes(preloadBlogRelations, filterScope(filter), keysetScope(keys, values)).Order(c.Sort).Limit(limit + 1).Find(&blogs)
+	if result.Error != nil {
+		s.logger.Error("unable to get blogs after page token", "error", result.Error)
+		return nil, result.Error
+	}
+	s.logger.Info(fmt.Sprintf("found %d blogs", len(blogs)))
+
+	pagination.Sort = c.Sort
+	pagination.Filter = c.Filter
+	pagination.Statuses = normalizeStatuses(c.Statuses)
+	pagination.Tag = c.Tag
+	pagination.AuthorID = c.AuthorID
+	pagination.NextPageToken = ""
+	if len(blogs) > limit {
+		blogs = blogs[:limit]
+		pagination.NextPageToken, err = encodePageToken(cursor{
+			Sort:     c.Sort,
+			Filter:   c.Filter,
+			Statuses: pagination.Statuses,
+			Tag:      pagination.Tag,
+			AuthorID: pagination.AuthorID,
+			Values:   cursorValues(keys, blogs[limit-1]),
+		}, s.pageTokenSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pagination.Items = blogs
+	return pagination, nil
+}
+
 func (s *Service) GetBlogByIDOrTitle(ctx context.Context, id uint, title string) (*Blog, error) {
-	query := gorm.G[Blog](s.db)
+	query := blogsWithRelations(s.db)
 	var blog Blog
 	var err error
 	if id > 0 {
@@ -75,30 +343,110 @@ func (s *Service) GetBlogByIDOrTitle(ctx context.Context, id uint, title string)
 	return &blog, err
 }
 
-func (s *Service) CreateBlog(ctx context.Context, blog Blog) (uint, error) {
+// CreateBlog creates the blog. When an idempotency key is given, retries with
+// the same key return the blog created by the first request.
+func (s *Service) CreateBlog(ctx context.Context, blog Blog, idempotencyKey string) (uint, error) {
+	if idempotencyKey != "" {
+		id, err := s.createBlogIdempotent(ctx, blog, idempotencyKey)
+		return id, authorError(err)
+	}
 	err := gorm.G[Blog](s.db).Create(ctx, &blog)
 	if err != nil {
 		s.logger.Error("unable to create blog", "id", blog.ID, "error", err)
 	}
-	return blog.ID, err
+	return blog.ID, authorError(err)
 }
 
-func (s *Service) UpdateBlog(ctx context.Context, blog Blog) error {
-	rows, err := gorm.G[Blog](s.db).Updates(ctx, blog)
+// UpdateBlog updates exactly the given fields of the blog, including zero values.
+// Updating the tags replaces all the tags of the blog. A non empty etag must
+// match the current version of the blog.
+func (s *Service) UpdateBlog(ctx context.Context, blog Blog, fields []string, etag string) error {
+	if len(fields) == 0 {
+		return status.Error(codes.InvalidArgument, "no fields to update")
+	}
+	version, err := parseETag(etag)
+	if err != nil {
+		return err
+	}
+	values := map[string]any{
+		"version": gorm.Expr("version + 1"),
+	}
+	updateTags := false
+	for _, field := range fields {
+		if field == "tags" {
+			updateTags = true
+			continue
+		}
+		column, ok := updatableFields[field]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown field %q in update mask", field)
+		}
+		values[column] = blog.fieldValue(column)
+	}
+
+	var rows int64
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the slug follows the title, the former slug keeps resolving
+		if _, ok := values["title"]; ok {
+			slug, err := updateSlug(tx, blog.ID, blog.Title)
+			if err != nil {
+				return err
+			}
+			values["slug"] = slug
+		}
+		query := tx.Model(&Blog{}).Where("id = ?", blog.ID)
+		if version > 0 {
+			query = query.Where("version = ?", version)
+		}
+		result := query.Updates(values)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errNotUpdated
+		}
+		rows = result.RowsAffected
+		if updateTags {
+			return replaceTags(tx, blog.ID, blog.Tags)
+		}
+		return nil
+	})
+	if errors.Is(err, errNotUpdated) {
+		return s.checkVersion(ctx, blog.ID, version)
+	}
 	if err != nil {
 		s.logger.Error("unable to update blog", "id", blog.ID, "error", err)
-	} else {
-		s.logger.Info("updated", "rows", rows)
+		return authorError(err)
 	}
-	return err
+	s.logger.Info("updated", "rows", rows)
+	return nil
 }
 
-func (s *Service) DeleteBlog(ctx context.Context, id uint) error {
-	rows, err := gorm.G[Blog](s.db).Where("id = ?", id).Delete(ctx)
+// DeleteBlog deletes the blog, which is kept until it is purged after the
+// deleted retention period. A non empty etag must match the current version
+// of the blog.
+func (s *Service) DeleteBlog(ctx context.Context, id uint, etag string) error {
+	version, err := parseETag(etag)
+	if err != nil {
+		return err
+	}
+	query := gorm.G[Blog](s.db).Where("id = ?", id)
+	if version > 0 {
+		query = query.Where("version = ?", version)
+	}
+	rows, err := query.Delete(ctx)
 	if err != nil {
 		s.logger.Error("unable to delete blog", "id", id, "error", err)
-	} else {
-		s.logger.Info("deleted", "rows", rows)
+		return err
+	}
+	if rows == 0 && version > 0 {
+		err = s.checkVersion(ctx, id, version)
+		if status.Code(err) == codes.NotFound {
+			// deleting a blog that does not exist is not an error
+			return nil
+		}
+		return err
 	}
-	return err
+	s.logger.Info("deleted", "rows", rows)
+	return nil
 }
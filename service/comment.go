@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// CommentStatus is the moderation state of a comment
+type CommentStatus string
+
+const (
+	// CommentPending comments wait for moderation, new comments are pending
+	CommentPending  CommentStatus = "pending"
+	CommentApproved CommentStatus = "approved"
+	CommentRejected CommentStatus = "rejected"
+	CommentSpam     CommentStatus = "spam"
+)
+
+// CommentsMode is how ListComments returns the comments
+type CommentsMode int
+
+const (
+	// CommentsTree pages the comments of the first level, with their replies nested
+	CommentsTree CommentsMode = iota
+	// CommentsFlat pages all the comments, oldest first, with their depth
+	CommentsFlat
+)
+
+type Commenter interface {
+	CreateComment(ctx context.Context, comment Comment) (uint, error)
+	GetComment(ctx context.Context, id uint) (*Comment, error)
+	GetComments(ctx context.Context, blogID uint, parentID uint, mode CommentsMode, statuses []CommentStatus, pagination *Pagination) (*Pagination, error)
+	ModerateComment(ctx context.Context, id uint, status CommentStatus) error
+}
+
+// Comment is a comment on a blog, or a reply to another comment of the blog.
+// Comments are deleted with their blog, and replies with the comment they reply to.
+type Comment struct {
+	ID         uint          `gorm:"primaryKey" json:"id"`
+	BlogID     uint          `gorm:"not null;index" json:"blog_id"`
+	Blog       Blog          `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+	ParentID   *uint         `gorm:"index" json:"parent_id,omitempty"`
+	Parent     *Comment      `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+	AuthorName string        `gorm:"not null;size:100" json:"author_name"`
+	Body       string        `gorm:"type:text;not null" json:"body"`
+	Status     CommentStatus `gorm:"not null;size:16;default:pending;index" json:"status"`
+	CreatedAt  time.Time     `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time     `gorm:"autoUpdateTime" json:"updated_at"`
+	// Depth is the number of levels below the first listed level, it is only
+	// set when listing comments
+	Depth int `gorm:"->;-:migration" json:"depth"`
+	// Replies are only set when listing comments as a tree
+	Replies []Comment `gorm:"-" json:"replies,omitempty"`
+}
+
+// TableName specifies the table name for the Comment model
+func (Comment) TableName() string {
+	return "comments"
+}
+
+// threadSQL selects the comments matching the first level condition and all
+// their replies, up to the first reply that is not in one of the statuses
+const threadSQL = `WITH RECURSIVE thread AS (
+	SELECT comments.*, 0 AS depth FROM comments WHERE %s AND status IN @statuses
+	UNION ALL
+	SELECT comments.*, thread.depth + 1 FROM comments JOIN thread ON comments.parent_id = thread.id
+	WHERE comments.status IN @statuses
+)`
+
+// findBlog returns the blog, or NotFound when the blog does not exist or is deleted
+func (s *Service) findBlog(ctx context.Context, id uint) (*Blog, error) {
+	blog, err := gorm.G[Blog](s.db).Where("id = ?", id).First(ctx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "blog not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &blog, nil
+}
+
+// CreateComment adds a pending comment to a published blog. Replies must be to
+// an approved comment of the same blog.
+func (s *Service) CreateComment(ctx context.Context, comment Comment) (uint, error) {
+	blog, err := s.findBlog(ctx, comment.BlogID)
+	if err != nil {
+		return 0, err
+	}
+	if blog.Status != BlogPublished {
+		return 0, status.Error(codes.FailedPrecondition, "comments can only be added to published blogs")
+	}
+	if comment.ParentID != nil {
+		parent, err := gorm.G[Comment](s.db).Where("id = ? AND blog_id = ?", *comment.ParentID, comment.BlogID).First(ctx)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, status.Error(codes.InvalidArgument, "parent comment not found in blog")
+		}
+		if err != nil {
+			return 0, err
+		}
+		if parent.Status != CommentApproved {
+			return 0, status.Error(codes.FailedPrecondition, "only approved comments can be replied to")
+		}
+	}
+	comment.Status = CommentPending
+	err = gorm.G[Comment](s.db).Create(ctx, &comment)
+	if err != nil {
+		s.logger.Error("unable to create comment", "blog_id", comment.BlogID, "error", err)
+		return 0, err
+	}
+	s.logger.Info("created comment", "id", comment.ID, "blog_id", comment.BlogID)
+	return comment.ID, nil
+}
+
+// GetComment returns the comment, comments of deleted blogs are not found
+func (s *Service) GetComment(ctx context.Context, id uint) (*Comment, error) {
+	comment, err := gorm.G[Comment](s.db).Where("id = ? AND blog_id IN (SELECT id FROM blogs WHERE deleted_at IS NULL)", id).First(ctx)
+	if err != nil {
+		s.logger.Error("unable to get comment", "id", id, "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "comment not found")
+		}
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// GetComments returns a page of the comments of the blog in one of the
+// statuses, only the approved ones when none is given. The first level is the
+// top level comments, or the replies of parentID when it is not 0.
+func (s *Service) GetComments(ctx context.Context, blogID uint, parentID uint, mode CommentsMode, statuses []CommentStatus, pagination *Pagination) (*Pagination, error) {
+	if _, err := s.findBlog(ctx, blogID); err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		statuses = []CommentStatus{CommentApproved}
+	}
+	level := "blog_id = @blog AND parent_id IS NULL"
+	if parentID > 0 {
+		level = "blog_id = @blog AND parent_id = @parent"
+	}
+	args := map[string]any{
+		"blog":     blogID,
+		"parent":   parentID,
+		"statuses": statuses,
+		"limit":    pagination.GetLimit(),
+		"offset":   pagination.GetOffset(),
+	}
+
+	var comments []Comment
+	var err error
+	if mode == CommentsFlat {
+		comments, err = s.getCommentsFlat(ctx, level, args, pagination)
+	} else {
+		comments, err = s.getCommentsTree(ctx, level, args, pagination)
+	}
+	if err != nil {
+		s.logger.Error("unable to get comments", "blog_id", blogID, "error", err)
+		return nil, err
+	}
+	s.logger.Info(fmt.Sprintf("found %d comments", len(comments)))
+	pagination.TotalPages = int(math.Ceil(float64(pagination.TotalItems) / float64(pagination.GetLimit())))
+	pagination.Items = comments
+	return pagination, nil
+}
+
+// getCommentsFlat pages the first level comments and all their replies
+func (s *Service) getCommentsFlat(ctx context.Context, level string, args map[string]any, pagination *Pagination) ([]Comment, error) {
+	thread := fmt.Sprintf(threadSQL, level)
+	err := s.db.WithContext(ctx).Raw(thread+" SELECT count(*) FROM thread", args).Scan(&pagination.TotalItems).Error
+	if err != nil {
+		return nil, err
+	}
+	var comments []Comment
+	err = s.db.WithContext(ctx).Raw(thread+" SELECT * FROM thread ORDER BY created_at, id LIMIT @limit OFFSET @offset", args).Scan(&comments).Error
+	return comments, err
+}
+
+// getCommentsTree pages the first level comments, and nests all their replies
+func (s *Service) getCommentsTree(ctx context.Context, level string, args map[string]any, pagination *Pagination) ([]Comment, error) {
+	firstLevel := func() *gorm.DB {
+		return s.db.WithContext(ctx).Model(&Comment{}).Where(level, args).Where("status IN ?", args["statuses"])
+	}
+	if err := firstLevel().Count(&pagination.TotalItems).Error; err != nil {
+		return nil, err
+	}
+	var comments []Comment
+	err := firstLevel().Order("created_at, id").Offset(pagination.GetOffset()).Limit(pagination.GetLimit()).Find(&comments).Error
+	if err != nil || len(comments) == 0 {
+		return comments, err
+	}
+
+	ids := make([]uint, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.ID
+	}
+	args["ids"] = ids
+	var replies []Comment
+	err = s.db.WithContext(ctx).Raw(fmt.Sprintf(threadSQL, "parent_id IN @ids")+" SELECT * FROM thread ORDER BY created_at, id", args).Scan(&replies).Error
+	if err != nil {
+		return nil, err
+	}
+	children := map[uint][]Comment{}
+	for _, reply := range replies {
+		children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+	}
+	var nest func(comment Comment, depth int) Comment
+	nest = func(comment Comment, depth int) Comment {
+		comment.Depth = depth
+		for _, reply := range children[comment.ID] {
+			comment.Replies = append(comment.Replies, nest(reply, depth+1))
+		}
+		return comment
+	}
+	for i, comment := range comments {
+		comments[i] = nest(comment, 0)
+	}
+	return comments, nil
+}
+
+// ModerateComment changes the moderation state of the comment, comments of
+// deleted blogs are not found
+func (s *Service) ModerateComment(ctx context.Context, id uint, state CommentStatus) error {
+	if !slices.Contains([]CommentStatus{CommentPending, CommentApproved, CommentRejected, CommentSpam}, state) {
+		return status.Errorf(codes.InvalidArgument, "invalid comment status %q", state)
+	}
+	rows, err := gorm.G[Comment](s.db).Where("id = ? AND blog_id IN (SELECT id FROM blogs WHERE deleted_at IS NULL)", id).
+		Update(ctx, "status", state)
+	if err != nil {
+		s.logger.Error("unable to moderate comment", "id", id, "error", err)
+		return err
+	}
+	if rows == 0 {
+		return status.Error(codes.NotFound, "comment not found")
+	}
+	s.logger.Info("moderated comment", "id", id, "status", state)
+	return nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cursor is the position after which the next page starts, encoded in page tokens
+type cursor struct {
+	Sort     string       `json:"s"`
+	Filter   string       `json:"f,omitempty"`
+	Statuses []BlogStatus `json:"st,omitempty"`
+	Tag      string       `json:"t,omitempty"`
+	AuthorID uint         `json:"a,omitempty"`
+	Values   []any        `json:"v"`
+}
+
+// errNoPageTokenSecret is returned when paging with page tokens without a
+// secret set with WithPageTokenSecret
+var errNoPageTokenSecret = status.Error(codes.Internal, "page token secret is not set")
+
+// encodePageToken returns an opaque, signed token for the cursor
+func encodePageToken(c cursor, secret []byte) (string, error) {
+	if len(secret) == 0 {
+		return "", errNoPageTokenSecret
+	}
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// decodePageToken verifies the signature of the token and returns its cursor
+func decodePageToken(token string, secret []byte) (cursor, error) {
+	var c cursor
+	invalid := status.Error(codes.InvalidArgument, "invalid page token")
+	if len(secret) == 0 {
+		return c, errNoPageTokenSecret
+	}
+
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return c, invalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return c, invalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return c, invalid
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return c, invalid
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&c); err != nil {
+		return c, invalid
+	}
+	return c, nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultDeletedRetention is how long deleted blogs are kept before they are purged
+const DefaultDeletedRetention = 30 * 24 * time.Hour
+
+// WithDeletedRetention sets how long deleted blogs are kept before they are purged
+func WithDeletedRetention(retention time.Duration) Option {
+	return func(s *Service) {
+		s.deletedRetention = retention
+	}
+}
+
+// UndeleteBlog restores a deleted blog that was not purged yet. Undeleting a
+// blog that is not deleted is not an error.
+func (s *Service) UndeleteBlog(ctx context.Context, id uint) error {
+	result := s.db.WithContext(ctx).Unscoped().Model(&Blog{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		s.logger.Error("unable to undelete blog", "id", id, "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return s.checkVersion(ctx, id, 0)
+	}
+	s.logger.Info("undeleted", "rows", result.RowsAffected)
+	return nil
+}
+
+// GetDeletedBlogs returns a page of the deleted blogs, the most recently deleted first
+func (s *Service) GetDeletedBlogs(ctx context.Context, pagination *Pagination) (*Pagination, error) {
+	deleted := func() *gorm.DB {
+		return s.db.WithContext(ctx).Unscoped().Model(&Blog{}).Where("deleted_at IS NOT NULL")
+	}
+
+	var totalItems int64
+	if err := deleted().Count(&totalItems).Error; err != nil {
+		s.logger.Error("unable to count deleted blogs", "error", err)
+		return nil, err
+	}
+	pagination.TotalItems = totalItems
+	pagination.TotalPages = int(math.Ceil(float64(totalItems) / float64(pagination.GetLimit())))
+
+	var blogs []Blog
+	result := deleted().Scopes(preloadBlogRelations).Order("deleted_at desc, id desc").Offset(pagination.GetOffset()).Limit(pagination.GetLimit()).Find(&blogs)
+	if result.Error != nil {
+		s.logger.Error("unable to get deleted blogs", "error", result.Error)
+		return nil, result.Error
+	}
+	s.logger.Info(fmt.Sprintf("found %d deleted blogs", result.RowsAffected))
+	pagination.Items = blogs
+	return pagination, nil
+}
+
+// purgeDeletedBlogs permanently deletes the blogs deleted before the retention period
+func (s *Service) purgeDeletedBlogs(ctx context.Context) error {
+	result := s.db.WithContext(ctx).Unscoped().
+		Where("deleted_at < ?", time.Now().Add(-s.deletedRetention)).
+		Delete(&Blog{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		s.logger.Info("purged deleted blogs", "rows", result.RowsAffected)
+	}
+	return nil
+}
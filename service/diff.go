@@ -0,0 +1,72 @@
+package service
+
+import "strings"
+
+// DiffOperation is what happened to a line between two texts
+type DiffOperation int
+
+const (
+	DiffEqual DiffOperation = iota
+	DiffInsert
+	DiffDelete
+)
+
+// DiffLine is a line of a diff
+type DiffLine struct {
+	Operation DiffOperation
+	Text      string
+}
+
+// diffLines returns the line diff turning a into b. Lines are matched using
+// their longest common subsequence, and deleted lines come before the lines
+// inserted in their place.
+func diffLines(a, b string) []DiffLine {
+	from, to := splitLines(a), splitLines(b)
+
+	// common[i][j] is the length of the longest common subsequence of from[i:] and to[j:]
+	common := make([][]int, len(from)+1)
+	for i := range common {
+		common[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	var diff []DiffLine
+	i, j := 0, 0
+	for i < len(from) && j < len(to) {
+		switch {
+		case from[i] == to[j]:
+			diff = append(diff, DiffLine{Operation: DiffEqual, Text: from[i]})
+			i++
+			j++
+		case common[i+1][j] >= common[i][j+1]:
+			diff = append(diff, DiffLine{Operation: DiffDelete, Text: from[i]})
+			i++
+		default:
+			diff = append(diff, DiffLine{Operation: DiffInsert, Text: to[j]})
+			j++
+		}
+	}
+	for ; i < len(from); i++ {
+		diff = append(diff, DiffLine{Operation: DiffDelete, Text: from[i]})
+	}
+	for ; j < len(to); j++ {
+		diff = append(diff, DiffLine{Operation: DiffInsert, Text: to[j]})
+	}
+	return diff
+}
+
+// splitLines splits the text into lines, an empty text has no lines
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want []DiffLine
+	}{
+		{
+			name: "should keep equal lines",
+			a:    "first\nsecond",
+			b:    "first\nsecond",
+			want: []DiffLine{{DiffEqual, "first"}, {DiffEqual, "second"}},
+		},
+		{
+			name: "should delete then insert a changed line",
+			a:    "first\nsecond\nthird",
+			b:    "first\nchanged\nthird",
+			want: []DiffLine{{DiffEqual, "first"}, {DiffDelete, "second"}, {DiffInsert, "changed"}, {DiffEqual, "third"}},
+		},
+		{
+			name: "should insert lines into an empty text",
+			a:    "",
+			b:    "first\nsecond\n",
+			want: []DiffLine{{DiffInsert, "first"}, {DiffInsert, "second"}},
+		},
+		{
+			name: "should delete every line of a removed text",
+			a:    "first\nsecond",
+			b:    "",
+			want: []DiffLine{{DiffDelete, "first"}, {DiffDelete, "second"}},
+		},
+		{
+			name: "should match the longest common subsequence",
+			a:    "a\nb\nc\nd",
+			b:    "b\nc\ne\na",
+			want: []DiffLine{{DiffDelete, "a"}, {DiffEqual, "b"}, {DiffEqual, "c"}, {DiffDelete, "d"}, {DiffInsert, "e"}, {DiffInsert, "a"}},
+		},
+		{
+			name: "should return no lines for empty texts",
+			a:    "",
+			b:    "",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diffLines(tt.a, tt.b))
+		})
+	}
+}
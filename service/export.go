@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// exportBatchSize is the number of blogs fetched from the export cursor at a time
+const exportBatchSize = 500
+
+// ExportBlogs sends every blog matching the filter, ordered by id. The blogs
+// are read from a single REPEATABLE READ snapshot through a server side
+// cursor, so that changes made during the export are not seen and only one
+// batch of blogs is held in memory at a time.
+func (s *Service) ExportBlogs(ctx context.Context, filter string, send func(Blog) error) error {
+	node, err := parseFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	exported := 0
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&Blog{}).Scopes(filterScope(node)).Order("id")
+		if err := tx.Exec("DECLARE export_blogs NO SCROLL CURSOR FOR ?", query).Error; err != nil {
+			return err
+		}
+		for {
+			var blogs []Blog
+			if err := tx.Scopes(preloadBlogRelations).Raw(fmt.Sprintf("FETCH %d FROM export_blogs", exportBatchSize)).Find(&blogs).Error; err != nil {
+				return err
+			}
+			for _, blog := range blogs {
+				if err := send(blog); err != nil {
+					return err
+				}
+				exported++
+			}
+			if len(blogs) < exportBatchSize {
+				return nil
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		s.logger.Error("unable to export blogs", "exported", exported, "error", err)
+		return err
+	}
+	s.logger.Info("exported", "blogs", exported)
+	return nil
+}
@@ -0,0 +1,438 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Filters follow a subset of the AIP-160 grammar (https://google.aip.dev/160):
+//
+//	expression  = sequence { "AND" sequence }
+//	sequence    = factor { factor }
+//	factor      = term { "OR" term }
+//	term        = [ "NOT" | "-" ] simple
+//	simple      = restriction | "(" expression ")"
+//	restriction = field comparator value
+//	comparator  = "=" | "!=" | "<" | "<=" | ">" | ">=" | ":"
+//
+// Values are quoted strings or bare literals. The ":" (has) comparator matches
+// a substring on text fields and is an equality check on other fields.
+
+// filterNode is a node of a parsed filter
+type filterNode interface {
+	// sql returns the parameterized condition of the node
+	sql() (string, []any)
+	// matches evaluates the node against the blog
+	matches(blog Blog) bool
+}
+
+type andNode struct {
+	children []filterNode
+}
+
+type orNode struct {
+	children []filterNode
+}
+
+type notNode struct {
+	child filterNode
+}
+
+type restrictionNode struct {
+	field      *schema.Field
+	comparator string
+	value      any
+}
+
+func (n andNode) sql() (string, []any) {
+	return joinNodes(n.children, " AND ")
+}
+
+func (n orNode) sql() (string, []any) {
+	return joinNodes(n.children, " OR ")
+}
+
+func (n notNode) sql() (string, []any) {
+	query, args := n.child.sql()
+	return "NOT (" + query + ")", args
+}
+
+func (n restrictionNode) sql() (string, []any) {
+	if n.comparator == ":" {
+		if text, ok := n.value.(string); ok {
+			return n.field.DBName + " ILIKE ?", []any{"%" + escapeLike(text) + "%"}
+		}
+		return n.field.DBName + " = ?", []any{n.value}
+	}
+	return n.field.DBName + " " + n.comparator + " ?", []any{n.value}
+}
+
+func (n andNode) matches(blog Blog) bool {
+	for _, child := range n.children {
+		if !child.matches(blog) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n orNode) matches(blog Blog) bool {
+	for _, child := range n.children {
+		if child.matches(blog) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n notNode) matches(blog Blog) bool {
+	return !n.child.matches(blog)
+}
+
+func (n restrictionNode) matches(blog Blog) bool {
+	value := reflect.ValueOf(blog).FieldByIndex(n.field.StructField.Index)
+	var cmp int
+	switch expected := n.value.(type) {
+	case string:
+		if n.comparator == ":" {
+			return strings.Contains(strings.ToLower(value.String()), strings.ToLower(expected))
+		}
+		cmp = strings.Compare(value.String(), expected)
+	case uint64:
+		cmp = compareOrdered(value.Uint(), expected)
+	case int64:
+		cmp = compareOrdered(value.Int(), expected)
+	case bool:
+		if value.Bool() == expected {
+			cmp = 0
+		} else {
+			cmp = 1
+		}
+	case time.Time:
+		cmp = value.Interface().(time.Time).Compare(expected)
+	}
+	switch n.comparator {
+	case "=", ":":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+func compareOrdered[T uint64 | int64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func joinNodes(nodes []filterNode, separator string) (string, []any) {
+	parts := make([]string, len(nodes))
+	var args []any
+	for i, node := range nodes {
+		query, nodeArgs := node.sql()
+		parts[i] = "(" + query + ")"
+		args = append(args, nodeArgs...)
+	}
+	return strings.Join(parts, separator), args
+}
+
+// escapeLike escapes the LIKE wildcards in the text
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+}
+
+// filterScope returns a scope applying the parsed filter, or no condition when
+// the filter is empty
+func filterScope(node filterNode) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if node == nil {
+			return db
+		}
+		query, args := node.sql()
+		return db.Where(query, args...)
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenComparator
+	tokenOpen
+	tokenClose
+)
+
+type filterToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// tokenizeFilter splits the filter into words, quoted strings, comparators and parentheses
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: tokenOpen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: tokenClose, text: ")", pos: i})
+			i++
+		case r == '"':
+			var text strings.Builder
+			start := i
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				text.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, filterToken{kind: tokenString, text: text.String(), pos: start})
+		case strings.ContainsRune("=!<>:", r):
+			start := i
+			i++
+			if i < len(runes) && runes[i] == '=' && r != '=' && r != ':' {
+				i++
+			}
+			comparator := string(runes[start:i])
+			if comparator == "!" {
+				return nil, fmt.Errorf("unexpected %q at position %d", comparator, start)
+			}
+			tokens = append(tokens, filterToken{kind: tokenComparator, text: comparator, pos: start})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`()"=!<>:`, runes[i]) {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: tokenWord, text: string(runes[start:i]), pos: start})
+		}
+	}
+	return tokens, nil
+}
+
+// filterParser is a recursive descent parser for filters on the fields of a model
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+	fields map[string]*schema.Field
+}
+
+// parseFilter parses the filter into an AST whose fields and values are
+// checked against the filterable Blog fields. An empty filter returns nil.
+func parseFilter(filter string) (filterNode, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	node, err := parseFilterTokens(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter %q: %v", filter, err)
+	}
+	return node, nil
+}
+
+func parseFilterTokens(filter string) (filterNode, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens, fields: blogFilterableFields()}
+	node, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := p.peek(); ok {
+		return nil, fmt.Errorf("unexpected %q at position %d", token.text, token.pos)
+	}
+	return node, nil
+}
+
+func (p *filterParser) peek() (filterToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	token, ok := p.peek()
+	if !ok {
+		return token, fmt.Errorf("unexpected end of filter")
+	}
+	p.pos++
+	return token, nil
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	token, ok := p.peek()
+	return ok && token.kind == tokenWord && token.text == keyword
+}
+
+func (p *filterParser) expression() (filterNode, error) {
+	nodes := []filterNode{}
+	for {
+		node, err := p.sequence()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		if !p.peekKeyword("AND") {
+			break
+		}
+		p.pos++
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return andNode{children: nodes}, nil
+}
+
+func (p *filterParser) sequence() (filterNode, error) {
+	nodes := []filterNode{}
+	for {
+		node, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		token, ok := p.peek()
+		if !ok || token.kind == tokenClose || p.peekKeyword("AND") {
+			break
+		}
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return andNode{children: nodes}, nil
+}
+
+func (p *filterParser) factor() (filterNode, error) {
+	nodes := []filterNode{}
+	for {
+		node, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		if !p.peekKeyword("OR") {
+			break
+		}
+		p.pos++
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return orNode{children: nodes}, nil
+}
+
+func (p *filterParser) term() (filterNode, error) {
+	token, ok := p.peek()
+	if ok && token.kind == tokenWord && (token.text == "NOT" || token.text == "-") {
+		p.pos++
+		node, err := p.simple()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{child: node}, nil
+	}
+	if ok && token.kind == tokenWord && strings.HasPrefix(token.text, "-") {
+		// "-field" negates the restriction on field
+		p.tokens[p.pos].text = token.text[1:]
+		node, err := p.simple()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{child: node}, nil
+	}
+	return p.simple()
+}
+
+func (p *filterParser) simple() (filterNode, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if token.kind == tokenOpen {
+		node, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		closing, err := p.next()
+		if err != nil || closing.kind != tokenClose {
+			return nil, fmt.Errorf("missing closing parenthesis for position %d", token.pos)
+		}
+		return node, nil
+	}
+	if token.kind != tokenWord {
+		return nil, fmt.Errorf("unexpected %q at position %d", token.text, token.pos)
+	}
+	return p.restriction(token)
+}
+
+func (p *filterParser) restriction(name filterToken) (filterNode, error) {
+	field, ok := p.fields[strings.ToLower(name.text)]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q at position %d", name.text, name.pos)
+	}
+	comparator, err := p.next()
+	if err != nil || comparator.kind != tokenComparator {
+		return nil, fmt.Errorf("expected a comparator after field %q", name.text)
+	}
+	value, err := p.next()
+	if err != nil || (value.kind != tokenWord && value.kind != tokenString) {
+		return nil, fmt.Errorf("expected a value after %s%s", name.text, comparator.text)
+	}
+	converted, err := convertFilterValue(field, value.text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for field %q: %v", name.text, err)
+	}
+	return restrictionNode{field: field, comparator: comparator.text, value: converted}, nil
+}
+
+// convertFilterValue converts the text of a value into the type of the field
+func convertFilterValue(field *schema.Field, text string) (any, error) {
+	if field.FieldType == reflect.TypeOf(time.Time{}) {
+		return time.Parse(time.RFC3339Nano, text)
+	}
+	switch field.FieldType.Kind() {
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return strconv.ParseUint(text, 10, 64)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return strconv.ParseInt(text, 10, 64)
+	case reflect.Bool:
+		return strconv.ParseBool(text)
+	}
+	return text, nil
+}
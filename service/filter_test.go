@@ -0,0 +1,160 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    string
+		wantSQL   string
+		wantArgs  []any
+		wantError string
+	}{
+		{
+			name:     "should compile a has restriction on text",
+			filter:   `title:"go"`,
+			wantSQL:  "title ILIKE ?",
+			wantArgs: []any{"%go%"},
+		},
+		{
+			name:     "should escape like wildcards",
+			filter:   `body:"100%"`,
+			wantSQL:  "body ILIKE ?",
+			wantArgs: []any{`%100\%%`},
+		},
+		{
+			name:     "should compile AND with a timestamp comparison",
+			filter:   `title:"go" AND created_at > "2026-01-01T00:00:00Z"`,
+			wantSQL:  "(title ILIKE ?) AND (created_at > ?)",
+			wantArgs: []any{"%go%", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "should bind OR tighter than implicit AND",
+			filter:   `id = 1 OR id = 2 title != "x"`,
+			wantSQL:  "((id = ?) OR (id = ?)) AND (title != ?)",
+			wantArgs: []any{uint64(1), uint64(2), "x"},
+		},
+		{
+			name:     "should negate restrictions and groups",
+			filter:   `-title:"go" AND NOT (id <= 3)`,
+			wantSQL:  "(NOT (title ILIKE ?)) AND (NOT (id <= ?))",
+			wantArgs: []any{"%go%", uint64(3)},
+		},
+		{
+			name:      "should fail on unknown field",
+			filter:    `password = "x"`,
+			wantError: `unknown field "password"`,
+		},
+		{
+			name:      "should fail on value of the wrong type",
+			filter:    `id > "abc"`,
+			wantError: `invalid value for field "id"`,
+		},
+		{
+			name:      "should fail on missing comparator",
+			filter:    `title`,
+			wantError: `expected a comparator`,
+		},
+		{
+			name:      "should fail on unbalanced parenthesis",
+			filter:    `(id = 1`,
+			wantError: `missing closing parenthesis`,
+		},
+		{
+			name:      "should fail on unterminated string",
+			filter:    `title = "go`,
+			wantError: `unterminated string`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := parseFilter(tt.filter)
+			if tt.wantError != "" {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, s.Code())
+				assert.Contains(t, s.Message(), tt.wantError)
+				return
+			}
+			assert.NoError(t, err)
+			query, args := node.sql()
+			assert.Equal(t, tt.wantSQL, query)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	blog := Blog{
+		ID:        7,
+		Title:     "Learning Go",
+		Body:      "body",
+		CreatedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		name   string
+		filter string
+		want   bool
+	}{
+		{name: "should match substring ignoring case", filter: `title:"go"`, want: true},
+		{name: "should not match other substring", filter: `title:"rust"`, want: false},
+		{name: "should compare timestamps", filter: `created_at > "2026-01-01T00:00:00Z"`, want: true},
+		{name: "should compare numbers", filter: `id >= 8`, want: false},
+		{name: "should combine with OR", filter: `id = 8 OR body = "body"`, want: true},
+		{name: "should negate", filter: `NOT title:"go"`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := parseFilter(tt.filter)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, node.matches(blog))
+		})
+	}
+}
+
+func TestStatusFilter(t *testing.T) {
+	filter, err := parseFilter(`title:"go"`)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		filter   filterNode
+		statuses []BlogStatus
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "should only select published blogs by default",
+			statuses: nil,
+			wantSQL:  "(status = ?)",
+			wantArgs: []any{"published"},
+		},
+		{
+			name:     "should select any of the sorted statuses",
+			statuses: []BlogStatus{BlogPublished, BlogDraft, BlogPublished},
+			wantSQL:  "(status = ?) OR (status = ?)",
+			wantArgs: []any{"draft", "published"},
+		},
+		{
+			name:     "should combine the statuses with the filter",
+			filter:   filter,
+			statuses: []BlogStatus{BlogArchived},
+			wantSQL:  "((status = ?)) AND (title ILIKE ?)",
+			wantArgs: []any{"archived", "%go%"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := statusFilter(tt.filter, normalizeStatuses(tt.statuses)).sql()
+			assert.Equal(t, tt.wantSQL, query)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
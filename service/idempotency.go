@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultIdempotencyKeyTTL is how long idempotency keys are kept when not configured
+const DefaultIdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyKey records the outcome of a request sent with an idempotency key
+type IdempotencyKey struct {
+	Key         string    `gorm:"primaryKey;size:255" json:"key"`
+	RequestHash string    `gorm:"not null;size:64" json:"request_hash"`
+	Response    []byte    `gorm:"type:bytea;not null" json:"response"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	ExpiresAt   time.Time `gorm:"not null;index" json:"expires_at"`
+}
+
+// TableName specifies the table name for the IdempotencyKey model
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+// requestHash returns a hash identifying the payload of a create request
+func requestHash(blog Blog) (string, error) {
+	payload, err := json.Marshal(struct {
+		Title    string     `json:"title"`
+		Body     string     `json:"body"`
+		Status   BlogStatus `json:"status,omitempty"`
+		Tags     []string   `json:"tags,omitempty"`
+		AuthorID *uint      `json:"author_id,omitempty"`
+	}{blog.Title, blog.Body, blog.Status, TagNames(blog.Tags), blog.AuthorID})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// createBlogIdempotent creates the blog once per key. Retries with the same key
+// and payload return the originally created blog ID.
+func (s *Service) createBlogIdempotent(ctx context.Context, blog Blog, key string) (uint, error) {
+	hash, err := requestHash(blog)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var stored IdempotencyKey
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("key = ?", key).Take(&stored).Error
+		switch {
+		case err == nil && stored.ExpiresAt.After(time.Now()):
+			if stored.RequestHash != hash {
+				return status.Error(codes.FailedPrecondition, "idempotency key was already used with a different request")
+			}
+			s.logger.Info("replaying idempotent request", "key", key)
+			return json.Unmarshal(stored.Response, &blog)
+		case err == nil:
+			// the key expired, it can be used again
+			if err := tx.Delete(&stored).Error; err != nil {
+				return err
+			}
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		}
+
+		if err := tx.Create(&blog).Error; err != nil {
+			return err
+		}
+		response, err := json.Marshal(blog)
+		if err != nil {
+			return err
+		}
+		err = tx.Create(&IdempotencyKey{
+			Key:         key,
+			RequestHash: hash,
+			Response:    response,
+			ExpiresAt:   time.Now().Add(s.idempotencyKeyTTL),
+		}).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return status.Error(codes.Aborted, "a request with the same idempotency key is in progress")
+		}
+		return err
+	})
+	if err != nil {
+		s.logger.Error("unable to create blog", "idempotency_key", key, "error", err)
+		return 0, err
+	}
+
+	// remove keys past their TTL so the table does not grow unbounded
+	tx := s.db.WithContext(ctx).Where("expires_at < ?", time.Now()).Delete(&IdempotencyKey{})
+	if tx.Error != nil {
+		s.logger.Error("unable to delete expired idempotency keys", "error", tx.Error)
+	}
+	return blog.ID, nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// ImportOutcome is what happened to an imported blog
+type ImportOutcome int
+
+const (
+	ImportInserted ImportOutcome = iota
+	// ImportSkipped is the outcome of a blog whose source id was already imported
+	ImportSkipped
+	ImportFailed
+)
+
+// ImportResult is the outcome of one imported blog
+type ImportResult struct {
+	Outcome ImportOutcome
+	Err     error
+}
+
+// ImportBlogs inserts a batch of imported blogs. Blogs with a source id that
+// was already imported are skipped, and timestamps set on the blogs are kept.
+// The batch is inserted with a single multi-row insert, and only when that
+// fails every blog is inserted on its own to find out which ones failed.
+func (s *Service) ImportBlogs(ctx context.Context, blogs []Blog) ([]ImportResult, error) {
+	results := make([]ImportResult, len(blogs))
+	var sourceIDs []string
+	for _, blog := range blogs {
+		if blog.SourceID != nil {
+			sourceIDs = append(sourceIDs, *blog.SourceID)
+		}
+	}
+	imported := map[string]bool{}
+	if len(sourceIDs) > 0 {
+		var existing []string
+		err := s.db.WithContext(ctx).Model(&Blog{}).Where("source_id IN ?", sourceIDs).Pluck("source_id", &existing).Error
+		if err != nil {
+			s.logger.Error("unable to get imported blogs", "error", err)
+			return nil, err
+		}
+		for _, sourceID := range existing {
+			imported[sourceID] = true
+		}
+	}
+
+	var pending []Blog
+	var indexes []int
+	for i, blog := range blogs {
+		if blog.SourceID != nil {
+			if imported[*blog.SourceID] {
+				results[i] = ImportResult{Outcome: ImportSkipped}
+				continue
+			}
+			// the same source id can also appear twice in the batch
+			imported[*blog.SourceID] = true
+		}
+		pending = append(pending, blog)
+		indexes = append(indexes, i)
+	}
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	// blogs with the same title in the batch need different slugs, the slugs
+	// taken concurrently make the insert fail and the blogs be inserted one by one
+	reserved := map[string]bool{}
+	for i := range pending {
+		slug, err := uniqueSlug(s.db.WithContext(ctx), pending[i].Title, 0, reserved)
+		if err != nil {
+			return nil, err
+		}
+		reserved[slug] = true
+		pending[i].Slug = slug
+	}
+
+	err := s.db.WithContext(ctx).Create(&pending).Error
+	if err == nil {
+		s.logger.Info("imported", "blogs", len(pending))
+		return results, nil
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	s.logger.Warn("unable to import batch, importing blogs one by one", "error", err)
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range pending {
+			// let the blog get a slug that is unique at the time it is created
+			pending[i].ID, pending[i].Slug = 0, ""
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				return tx.Create(&pending[i]).Error
+			})
+			switch {
+			case err == nil:
+			case errors.Is(err, gorm.ErrDuplicatedKey) && s.sourceImported(tx, pending[i].SourceID):
+				// imported concurrently since the source ids were checked
+				results[indexes[i]] = ImportResult{Outcome: ImportSkipped}
+			default:
+				s.logger.Error("unable to import blog", "index", indexes[i], "error", err)
+				results[indexes[i]] = ImportResult{Outcome: ImportFailed, Err: importError(err)}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// sourceImported reports whether a blog with the source id was imported
+func (s *Service) sourceImported(tx *gorm.DB, sourceID *string) bool {
+	if sourceID == nil {
+		return false
+	}
+	var count int64
+	err := tx.Model(&Blog{}).Unscoped().Where("source_id = ?", *sourceID).Count(&count).Error
+	return err == nil && count > 0
+}
+
+// importError keeps the status of known errors and reports others as Aborted
+func importError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Aborted, "unable to import blog: %v", err)
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+
+	"gorm.io/gorm"
+)
+
+// DefaultSearchLanguage is the text search configuration used when not configured
+const DefaultSearchLanguage = "english"
+
+var searchLanguagePattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// AutoMigrate creates or updates the tables used by the service. The search
+// language is the Postgres text search configuration the blogs are indexed
+// with, changing it requires dropping the blogs.search_vector column.
+func AutoMigrate(db *gorm.DB, searchLanguage string) error {
+	if !searchLanguagePattern.MatchString(searchLanguage) {
+		return fmt.Errorf("invalid search language %q", searchLanguage)
+	}
+	err := db.AutoMigrate(&Tag{}, &Author{}, &Blog{}, &IdempotencyKey{}, &BlogChange{}, &BlogRevision{}, &BlogSlug{}, &Comment{}, &Reaction{}, &ReactionCount{}, &BlogView{})
+	if err != nil {
+		return err
+	}
+
+	// the search vector is generated by the database so it is always up to date
+	// with the title and body, which are weighted by importance
+	err = db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(fmt.Sprintf(`ALTER TABLE blogs ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (
+				setweight(to_tsvector('%[1]s', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('%[1]s', coalesce(body, '')), 'B')
+			) STORED`, searchLanguage)).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec("CREATE INDEX IF NOT EXISTS idx_blogs_search_vector ON blogs USING GIN (search_vector)").Error
+		if err != nil {
+			return err
+		}
+		// the primary key of blog_tags starts with blog_id, tags are also looked up the other way
+		err = tx.Exec("CREATE INDEX IF NOT EXISTS idx_blog_tags_tag_id ON blog_tags (tag_id)").Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec(changesTriggerSQL).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(revisionsTriggerSQL).Error
+	})
+	if err != nil {
+		return err
+	}
+	return backfillSlugs(db)
+}
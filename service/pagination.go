@@ -1,18 +1,36 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 type Pagination struct {
-	Limit      int    `json:"limit"`
-	Page       int    `json:"page"`
-	Sort       string `json:"sort"`
-	TotalItems int64  `json:"total_items"`
-	TotalPages int    `json:"total_pages"`
-	Items      any    `json:"items"`
+	Limit         int          `json:"limit"`
+	Page          int          `json:"page"`
+	Sort          string       `json:"sort"`
+	Filter        string       `json:"filter"`
+	Statuses      []BlogStatus `json:"statuses"`
+	Tag           string       `json:"tag"`
+	AuthorID      uint         `json:"author_id"`
+	PageToken     string       `json:"page_token"`
+	NextPageToken string       `json:"next_page_token"`
+	TotalItems    int64        `json:"total_items"`
+	TotalPages    int          `json:"total_pages"`
+	Items         any          `json:"items"`
 }
 
 func (p *Pagination) GetOffset() int {
@@ -33,22 +51,215 @@ func (p *Pagination) GetPage() int {
 	return p.Page
 }
 
-func (p *Pagination) GetSort() string {
-	if p.Sort == "" {
-		p.Sort = "Id desc"
-	}
-	return p.Sort
-}
-
-func paginate(value any, pagination *Pagination, db *gorm.DB) func(db *gorm.DB) *gorm.DB {
+// paginate counts the items matching the filter, which sets TotalItems and
+// TotalPages, and returns the scope selecting the page by its offset. It is
+// only used when paging by page number: page tokens select the following page
+// with a keyset query and don't count the items.
+func paginate(value any, pagination *Pagination, keys []sortKey, filter filterNode, db *gorm.DB) (func(db *gorm.DB) *gorm.DB, error) {
 	var totalItems int64
-	db.Model(value).Count(&totalItems)
+	if err := db.Model(value).Scopes(filterScope(filter)).Count(&totalItems).Error; err != nil {
+		return nil, err
+	}
 
 	pagination.TotalItems = totalItems
-	totalPages := int(math.Ceil(float64(totalItems) / float64(pagination.Limit)))
+	totalPages := int(math.Ceil(float64(totalItems) / float64(pagination.GetLimit())))
 	pagination.TotalPages = totalPages
 
 	return func(db *gorm.DB) *gorm.DB {
-		return db.Offset(pagination.GetOffset()).Limit(pagination.GetLimit()).Order(pagination.GetSort())
+		return db.Offset(pagination.GetOffset()).Limit(pagination.GetLimit()).Order(orderClause(keys))
+	}, nil
+}
+
+// sortKey is a column the results are ordered by
+type sortKey struct {
+	field *schema.Field
+	desc  bool
+}
+
+var (
+	blogFieldsOnce   sync.Once
+	sortableFields   map[string]*schema.Field
+	sortableNames    []string
+	filterableFields map[string]*schema.Field
+)
+
+// parseBlogFields indexes the Blog columns by column and Go field name in lower
+// case. Only scalar columns can be filtered by, and long text columns such as
+// the body cannot be sorted by.
+func parseBlogFields() {
+	blogFieldsOnce.Do(func() {
+		blogSchema, err := schema.Parse(&Blog{}, &sync.Map{}, schema.NamingStrategy{})
+		if err != nil {
+			panic(fmt.Sprintf("unable to parse blog schema: %v", err))
+		}
+		sortableFields = map[string]*schema.Field{}
+		filterableFields = map[string]*schema.Field{}
+		for _, field := range blogSchema.Fields {
+			if field.DBName == "" || !isScalarType(field.FieldType) {
+				continue
+			}
+			filterableFields[field.DBName] = field
+			filterableFields[strings.ToLower(field.Name)] = field
+			if field.DataType == "text" || field.FieldType.Kind() == reflect.Bool {
+				continue
+			}
+			sortableFields[field.DBName] = field
+			sortableFields[strings.ToLower(field.Name)] = field
+			sortableNames = append(sortableNames, field.DBName)
+		}
+		slices.Sort(sortableNames)
+	})
+}
+
+// blogSortableFields returns the Blog columns that can be sorted by and their names
+func blogSortableFields() (map[string]*schema.Field, []string) {
+	parseBlogFields()
+	return sortableFields, sortableNames
+}
+
+// blogFilterableFields returns the Blog columns that can be filtered by
+func blogFilterableFields() map[string]*schema.Field {
+	parseBlogFields()
+	return filterableFields
+}
+
+func isScalarType(t reflect.Type) bool {
+	if t == reflect.TypeOf(time.Time{}) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// parseSort parses a sort such as "created_at desc, title asc" into sort keys.
+// Fields are checked against the sortable Blog columns, directions default to
+// ascending, and the primary key is always added last as a tie-breaker so that
+// every row has a unique position.
+func parseSort(sort string) ([]sortKey, error) {
+	fields, names := blogSortableFields()
+	if strings.TrimSpace(sort) == "" {
+		sort = "id desc"
+	}
+
+	var keys []sortKey
+	seen := map[string]bool{}
+	for _, item := range strings.Split(sort, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sort %q: expected \"field [asc|desc]\" items separated by commas", sort)
+		}
+		field, ok := fields[strings.ToLower(parts[0])]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sort %q: unknown field %q, sortable fields are %s", sort, parts[0], strings.Join(names, ", "))
+		}
+		if seen[field.DBName] {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sort %q: field %q is repeated", sort, parts[0])
+		}
+		seen[field.DBName] = true
+		key := sortKey{field: field}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc", "ascending":
+			case "desc", "descending":
+				key.desc = true
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "invalid sort %q: unknown direction %q, expected asc or desc", sort, parts[1])
+			}
+		}
+		keys = append(keys, key)
+	}
+	if !seen["id"] {
+		keys = append(keys, sortKey{field: fields["id"]})
+	}
+	return keys, nil
+}
+
+// keysetScope returns the condition selecting the rows positioned after the
+// given values, e.g. (a > ?) OR (a = ? AND id > ?) when sorting by a, id
+func keysetScope(keys []sortKey, values []any) func(db *gorm.DB) *gorm.DB {
+	var conditions []string
+	var args []any
+	for i, key := range keys {
+		var parts []string
+		for _, previous := range keys[:i] {
+			parts = append(parts, previous.field.DBName+" = ?")
+		}
+		op := ">"
+		if key.desc {
+			op = "<"
+		}
+		parts = append(parts, key.field.DBName+" "+op+" ?")
+		conditions = append(conditions, "("+strings.Join(parts, " AND ")+")")
+		args = append(args, values[:i+1]...)
+	}
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(strings.Join(conditions, " OR "), args...)
+	}
+}
+
+// orderClause returns the ORDER BY clause for the sort keys
+func orderClause(keys []sortKey) string {
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key.field.DBName + " asc"
+		if key.desc {
+			parts[i] = key.field.DBName + " desc"
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// cursorValues returns the values of the sort keys for the blog
+func cursorValues(keys []sortKey, blog Blog) []any {
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		value, _ := key.field.ValueOf(context.Background(), reflect.ValueOf(blog))
+		if t, ok := value.(time.Time); ok {
+			value = t.Format(time.RFC3339Nano)
+		}
+		values[i] = value
+	}
+	return values
+}
+
+// decodeCursorValues converts the values stored in a page token back into the
+// types of their columns
+func decodeCursorValues(keys []sortKey, values []any) ([]any, error) {
+	invalid := status.Error(codes.InvalidArgument, "invalid page token")
+	if len(keys) != len(values) {
+		return nil, invalid
+	}
+	decoded := make([]any, len(values))
+	for i, key := range keys {
+		var err error
+		switch value := values[i].(type) {
+		case json.Number:
+			switch key.field.FieldType.Kind() {
+			case reflect.Uint, reflect.Uint32, reflect.Uint64:
+				decoded[i], err = strconv.ParseUint(value.String(), 10, 64)
+			case reflect.Int, reflect.Int32, reflect.Int64:
+				decoded[i], err = strconv.ParseInt(value.String(), 10, 64)
+			default:
+				err = invalid
+			}
+		case string:
+			switch {
+			case key.field.FieldType == reflect.TypeOf(time.Time{}):
+				decoded[i], err = time.Parse(time.RFC3339Nano, value)
+			case key.field.FieldType.Kind() == reflect.String:
+				decoded[i] = value
+			default:
+				err = invalid
+			}
+		default:
+			err = invalid
+		}
+		if err != nil {
+			return nil, invalid
+		}
 	}
+	return decoded, nil
 }
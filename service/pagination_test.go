@@ -0,0 +1,141 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPageToken(t *testing.T) {
+	secret := []byte("secret")
+	keys, err := parseSort("Id desc")
+	assert.NoError(t, err)
+	token, err := encodePageToken(cursor{
+		Sort:   orderClause(keys),
+		Values: cursorValues(keys, Blog{ID: 42}),
+	}, secret)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		token      string
+		secret     []byte
+		wantValues []any
+		wantError  codes.Code
+	}{
+		{
+			name:       "should decode token successfully",
+			token:      token,
+			secret:     secret,
+			wantValues: []any{uint64(42)},
+		},
+		{
+			name:      "should fail when token was tampered with",
+			token:     "x" + token,
+			secret:    secret,
+			wantError: codes.InvalidArgument,
+		},
+		{
+			name:      "should fail when token was signed with another secret",
+			token:     token,
+			secret:    []byte("another secret"),
+			wantError: codes.InvalidArgument,
+		},
+		{
+			name:      "should fail when no secret is set",
+			token:     token,
+			wantError: codes.Internal,
+		},
+		{
+			name:      "should fail when token is malformed",
+			token:     "not a token",
+			secret:    secret,
+			wantError: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := decodePageToken(tt.token, tt.secret)
+			if tt.wantError != codes.OK {
+				assert.Equal(t, tt.wantError, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "id desc", c.Sort)
+			values, err := decodeCursorValues(keys, c.Values)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantValues, values)
+		})
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		sort      string
+		wantOrder string
+		wantError string
+	}{
+		{
+			name:      "should default to id descending",
+			sort:      "",
+			wantOrder: "id desc",
+		},
+		{
+			name:      "should add id as tie-breaker",
+			sort:      "created_at desc",
+			wantOrder: "created_at desc, id asc",
+		},
+		{
+			name:      "should sort by multiple columns",
+			sort:      "CreatedAt DESCENDING, title",
+			wantOrder: "created_at desc, title asc, id asc",
+		},
+		{
+			name:      "should not repeat id",
+			sort:      "title, Id DESC",
+			wantOrder: "title asc, id desc",
+		},
+		{
+			name:      "should fail on unknown field",
+			sort:      "password asc",
+			wantError: `unknown field "password"`,
+		},
+		{
+			name:      "should fail on field that is not sortable",
+			sort:      "body asc",
+			wantError: `unknown field "body"`,
+		},
+		{
+			name:      "should fail on injected sql",
+			sort:      "id; DROP TABLE blogs",
+			wantError: "expected",
+		},
+		{
+			name:      "should fail on unknown direction",
+			sort:      "id sideways",
+			wantError: `unknown direction "sideways"`,
+		},
+		{
+			name:      "should fail on repeated field",
+			sort:      "title asc, title desc",
+			wantError: `field "title" is repeated`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := parseSort(tt.sort)
+			if tt.wantError != "" {
+				s, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, s.Code())
+				assert.Contains(t, s.Message(), tt.wantError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOrder, orderClause(keys))
+		})
+	}
+}
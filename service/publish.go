@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// DefaultPublishInterval is how often scheduled blogs are checked for publishing
+const DefaultPublishInterval = 10 * time.Second
+
+// BlogStatus is the stage of a blog in its publishing lifecycle
+type BlogStatus string
+
+const (
+	BlogDraft BlogStatus = "draft"
+	// BlogScheduled blogs are published at their PublishAt time
+	BlogScheduled BlogStatus = "scheduled"
+	BlogPublished BlogStatus = "published"
+	BlogArchived  BlogStatus = "archived"
+)
+
+// WithPublishInterval sets how often scheduled blogs are checked for publishing
+func WithPublishInterval(interval time.Duration) Option {
+	return func(s *Service) {
+		s.publishInterval = interval
+	}
+}
+
+// BeforeCreate gives new blogs a unique slug and the existing or new tags with
+// their tag names, publishes the ones that have no status, and records when the
+// published ones were published
+func (b *Blog) BeforeCreate(tx *gorm.DB) error {
+	db := tx.Session(&gorm.Session{NewDB: true})
+	if b.Slug == "" {
+		slug, err := uniqueSlug(db, b.Title, 0, nil)
+		if err != nil {
+			return err
+		}
+		b.Slug = slug
+	}
+	if len(b.Tags) > 0 {
+		tags, err := findOrCreateTags(db, b.Tags)
+		if err != nil {
+			return err
+		}
+		b.Tags = tags
+	}
+	if b.Status == "" {
+		b.Status = BlogPublished
+	}
+	if b.Status == BlogPublished && b.PublishAt == nil {
+		publishAt := time.Now()
+		if !b.CreatedAt.IsZero() {
+			publishAt = b.CreatedAt
+		}
+		b.PublishAt = &publishAt
+	}
+	return nil
+}
+
+// normalizeStatuses sorts the statuses and removes duplicates, and returns
+// only the published status when none is given
+func normalizeStatuses(statuses []BlogStatus) []BlogStatus {
+	if len(statuses) == 0 {
+		return []BlogStatus{BlogPublished}
+	}
+	statuses = slices.Clone(statuses)
+	slices.Sort(statuses)
+	return slices.Compact(statuses)
+}
+
+// statusFilter restricts the filter to the blogs in one of the statuses
+func statusFilter(filter filterNode, statuses []BlogStatus) filterNode {
+	field := blogFilterableFields()["status"]
+	var restrictions orNode
+	for _, s := range statuses {
+		restrictions.children = append(restrictions.children, restrictionNode{field: field, comparator: "=", value: string(s)})
+	}
+	if filter == nil {
+		return restrictions
+	}
+	return andNode{children: []filterNode{restrictions, filter}}
+}
+
+// PublishBlog publishes the blog, or schedules it to be published at publishAt
+// when it is in the future. Publishing a published blog is not an error, but
+// it cannot be scheduled without being unpublished first. A non empty etag
+// must match the current version of the blog.
+func (s *Service) PublishBlog(ctx context.Context, id uint, publishAt time.Time, etag string) error {
+	now := time.Now()
+	values := map[string]any{
+		"status":     BlogPublished,
+		"publish_at": now,
+		"version":    gorm.Expr("version + 1"),
+	}
+	if publishAt.After(now) {
+		values["status"] = BlogScheduled
+		values["publish_at"] = publishAt
+	}
+	err := s.changeStatus(ctx, id, values, etag)
+	if err != nil {
+		return err
+	}
+	s.logger.Info("published", "id", id, "status", values["status"], "publish_at", values["publish_at"])
+	return nil
+}
+
+// UnpublishBlog turns the blog back into a draft, or archives it. A non empty
+// etag must match the current version of the blog.
+func (s *Service) UnpublishBlog(ctx context.Context, id uint, archive bool, etag string) error {
+	values := map[string]any{
+		"status":     BlogDraft,
+		"publish_at": nil,
+		"version":    gorm.Expr("version + 1"),
+	}
+	if archive {
+		values = map[string]any{
+			"status":  BlogArchived,
+			"version": gorm.Expr("version + 1"),
+		}
+	}
+	err := s.changeStatus(ctx, id, values, etag)
+	if err != nil {
+		return err
+	}
+	s.logger.Info("unpublished", "id", id, "status", values["status"])
+	return nil
+}
+
+// changeStatus updates the blog to the status in values unless it is already
+// in that status, except for rescheduling. Scheduling a published blog fails
+// with FailedPrecondition.
+func (s *Service) changeStatus(ctx context.Context, id uint, values map[string]any, etag string) error {
+	version, err := parseETag(etag)
+	if err != nil {
+		return err
+	}
+	target := values["status"].(BlogStatus)
+	query := s.db.WithContext(ctx).Model(&Blog{}).Where("id = ?", id)
+	if target == BlogScheduled {
+		// scheduled blogs can be rescheduled
+		query = query.Where("status <> ?", BlogPublished)
+	} else {
+		query = query.Where("status <> ?", target)
+	}
+	if version > 0 {
+		query = query.Where("version = ?", version)
+	}
+	result := query.Updates(values)
+	if result.Error != nil {
+		s.logger.Error("unable to change blog status", "id", id, "status", target, "error", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	// nothing was updated, find out why
+	blog, err := gorm.G[Blog](s.db).Where("id = ?", id).First(ctx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return status.Error(codes.NotFound, "blog not found")
+	}
+	if err != nil {
+		return err
+	}
+	if version > 0 && blog.Version != version {
+		return status.Error(codes.Aborted, "blog was modified, etag does not match")
+	}
+	if target == BlogScheduled && blog.Status == BlogPublished {
+		return status.Error(codes.FailedPrecondition, "blog is already published, unpublish it before scheduling it")
+	}
+	return nil
+}
+
+// publishScheduledBlogs publishes the scheduled blogs whose time has come
+func (s *Service) publishScheduledBlogs(ctx context.Context) error {
+	result := s.db.WithContext(ctx).Model(&Blog{}).
+		Where("status = ? AND publish_at <= ?", BlogScheduled, time.Now()).
+		Updates(map[string]any{
+			"status":  BlogPublished,
+			"version": gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		s.logger.Info("published scheduled blogs", "rows", result.RowsAffected)
+	}
+	return nil
+}
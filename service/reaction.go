@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReactionType is the kind of a reaction to a blog
+type ReactionType string
+
+const (
+	ReactionLike       ReactionType = "like"
+	ReactionLove       ReactionType = "love"
+	ReactionLaugh      ReactionType = "laugh"
+	ReactionInsightful ReactionType = "insightful"
+	ReactionCelebrate  ReactionType = "celebrate"
+)
+
+// Reaction is the reaction of a user to a blog, a user reacts at most once
+// with every type
+type Reaction struct {
+	BlogID    uint         `gorm:"primaryKey;autoIncrement:false" json:"blog_id"`
+	Blog      Blog         `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+	UserID    string       `gorm:"primaryKey;size:100" json:"user_id"`
+	Type      ReactionType `gorm:"primaryKey;size:16" json:"type"`
+	CreatedAt time.Time    `gorm:"autoCreateTime;index" json:"created_at"`
+}
+
+// TableName specifies the table name for the Reaction model
+func (Reaction) TableName() string {
+	return "reactions"
+}
+
+// ReactionCount is the number of reactions of a type to a blog, kept up to
+// date in the same transaction as the reactions
+type ReactionCount struct {
+	BlogID uint         `gorm:"primaryKey;autoIncrement:false" json:"blog_id"`
+	Type   ReactionType `gorm:"primaryKey;size:16" json:"type"`
+	Count  int64        `gorm:"not null;default:0" json:"count"`
+}
+
+// TableName specifies the table name for the ReactionCount model
+func (ReactionCount) TableName() string {
+	return "reaction_counts"
+}
+
+// AddReaction adds the reaction to a published blog. Adding a reaction the
+// user already added has no effect.
+func (s *Service) AddReaction(ctx context.Context, reaction Reaction) error {
+	blog, err := s.findBlog(ctx, reaction.BlogID)
+	if err != nil {
+		return err
+	}
+	if blog.Status != BlogPublished {
+		return status.Error(codes.FailedPrecondition, "reactions can only be added to published blogs")
+	}
+	var added bool
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&reaction)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		added = true
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "blog_id"}, {Name: "type"}},
+			DoUpdates: clause.Set{{Column: clause.Column{Name: "count"}, Value: gorm.Expr("reaction_counts.count + 1")}},
+		}).Create(&ReactionCount{BlogID: reaction.BlogID, Type: reaction.Type, Count: 1}).Error
+	})
+	if err != nil {
+		s.logger.Error("unable to add reaction", "blog_id", reaction.BlogID, "type", reaction.Type, "error", err)
+		return err
+	}
+	s.logger.Info("added reaction", "blog_id", reaction.BlogID, "type", reaction.Type, "added", added)
+	return nil
+}
+
+// RemoveReaction removes the reaction from the blog. Removing a reaction that
+// does not exist has no effect.
+func (s *Service) RemoveReaction(ctx context.Context, reaction Reaction) error {
+	if _, err := s.findBlog(ctx, reaction.BlogID); err != nil {
+		return err
+	}
+	var removed bool
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("blog_id = ? AND user_id = ? AND type = ?", reaction.BlogID, reaction.UserID, reaction.Type).Delete(&Reaction{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		removed = true
+		return tx.Model(&ReactionCount{}).
+			Where("blog_id = ? AND type = ?", reaction.BlogID, reaction.Type).
+			Update("count", gorm.Expr("count - 1")).Error
+	})
+	if err != nil {
+		s.logger.Error("unable to remove reaction", "blog_id", reaction.BlogID, "type", reaction.Type, "error", err)
+		return err
+	}
+	s.logger.Info("removed reaction", "blog_id", reaction.BlogID, "type", reaction.Type, "removed", removed)
+	return nil
+}
+
+// GetReactions returns a page of the reactions to the blog, the most recent
+// first. When reactionType is not empty only the reactions of that type are
+// returned.
+func (s *Service) GetReactions(ctx context.Context, blogID uint, reactionType ReactionType, pagination *Pagination) (*Pagination, error) {
+	if _, err := s.findBlog(ctx, blogID); err != nil {
+		return nil, err
+	}
+	reactions := func() *gorm.DB {
+		query := s.db.WithContext(ctx).Model(&Reaction{}).Where("blog_id = ?", blogID)
+		if reactionType != "" {
+			query = query.Where("type = ?", reactionType)
+		}
+		return query
+	}
+
+	var totalItems int64
+	if err := reactions().Count(&totalItems).Error; err != nil {
+		s.logger.Error("unable to count reactions", "blog_id", blogID, "error", err)
+		return nil, err
+	}
+	pagination.TotalItems = totalItems
+	pagination.TotalPages = int(math.Ceil(float64(totalItems) / float64(pagination.GetLimit())))
+
+	var items []Reaction
+	result := reactions().Order("created_at desc, user_id, type").Offset(pagination.GetOffset()).Limit(pagination.GetLimit()).Find(&items)
+	if result.Error != nil {
+		s.logger.Error("unable to get reactions", "blog_id", blogID, "error", result.Error)
+		return nil, result.Error
+	}
+	s.logger.Info(fmt.Sprintf("found %d reactions", result.RowsAffected))
+	pagination.Items = items
+	return pagination, nil
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// RevisionAction is the kind of change that made a revision
+type RevisionAction string
+
+const (
+	RevisionCreated   RevisionAction = "created"
+	RevisionUpdated   RevisionAction = "updated"
+	RevisionDeleted   RevisionAction = "deleted"
+	RevisionUndeleted RevisionAction = "undeleted"
+)
+
+// BlogRevision is the state of a blog after a change, recorded by a trigger on
+// the blogs table in the transaction of the change. Revisions are never
+// modified and are only removed when their blog is purged.
+type BlogRevision struct {
+	ID        uint           `gorm:"primaryKey" json:"id"`
+	BlogID    uint           `gorm:"not null;uniqueIndex:idx_blog_revisions_number" json:"blog_id"`
+	Blog      Blog           `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+	Number    uint           `gorm:"not null;uniqueIndex:idx_blog_revisions_number" json:"number"`
+	Action    RevisionAction `gorm:"not null;size:16" json:"action"`
+	Title     string         `gorm:"not null" json:"title"`
+	Body      string         `gorm:"type:text" json:"body"`
+	Editor    string         `gorm:"not null;default:''" json:"editor"`
+	CreatedAt time.Time      `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for the BlogRevision model
+func (BlogRevision) TableName() string {
+	return "blog_revisions"
+}
+
+// revisionsTriggerSQL records a revision for every insert of the blogs table
+// and every update of a title or body, or of the deletion, with the editor set
+// on the transaction by setEditor. Updates of other columns don't record a
+// revision. Blogs that existed before revisions were recorded get an initial
+// revision.
+const revisionsTriggerSQL = `
+CREATE OR REPLACE FUNCTION record_blog_revision() RETURNS trigger AS $$
+DECLARE
+	revision_action text;
+BEGIN
+	IF TG_OP = 'INSERT' THEN
+		revision_action := 'created';
+	ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+		revision_action := 'deleted';
+	ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+		revision_action := 'undeleted';
+	ELSE
+		revision_action := 'updated';
+	END IF;
+	INSERT INTO blog_revisions (blog_id, number, action, title, body, editor, created_at)
+		VALUES (
+			NEW.id,
+			(SELECT coalesce(max(number), 0) + 1 FROM blog_revisions WHERE blog_id = NEW.id),
+			revision_action, NEW.title, NEW.body,
+			coalesce(current_setting('blog.editor', true), ''), now()
+		);
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS record_blog_revision ON blogs;
+CREATE TRIGGER record_blog_revision AFTER INSERT ON blogs
+	FOR EACH ROW EXECUTE FUNCTION record_blog_revision();
+
+DROP TRIGGER IF EXISTS record_blog_revision_update ON blogs;
+CREATE TRIGGER record_blog_revision_update AFTER UPDATE ON blogs
+	FOR EACH ROW
+	WHEN (OLD.title IS DISTINCT FROM NEW.title OR OLD.body IS DISTINCT FROM NEW.body
+		OR OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+	EXECUTE FUNCTION record_blog_revision();
+
+CREATE OR REPLACE FUNCTION reject_blog_revision_update() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'blog revisions cannot be modified';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS reject_blog_revision_update ON blog_revisions;
+CREATE TRIGGER reject_blog_revision_update BEFORE UPDATE ON blog_revisions
+	FOR EACH ROW EXECUTE FUNCTION reject_blog_revision_update();
+
+INSERT INTO blog_revisions (blog_id, number, action, title, body, editor, created_at)
+	SELECT id, 1, 'created', title, body, '', updated_at FROM blogs
+	WHERE NOT EXISTS (SELECT 1 FROM blog_revisions WHERE blog_id = blogs.id);
+`
+
+type editorKey struct{}
+
+// WithEditor returns a context recording the given editor in the revisions of
+// the changes made with it
+func WithEditor(ctx context.Context, editor string) context.Context {
+	return context.WithValue(ctx, editorKey{}, editor)
+}
+
+// editorFrom returns the editor set on the context, if any
+func editorFrom(ctx context.Context) string {
+	editor, _ := ctx.Value(editorKey{}).(string)
+	return editor
+}
+
+// registerEditorCallbacks makes every write to the blogs table set the editor
+// of the context on its transaction, where the revisions trigger reads it
+func registerEditorCallbacks(db *gorm.DB) error {
+	callbacks := db.Callback()
+	if callbacks.Create().Get("blog:set_editor") != nil {
+		return nil
+	}
+	err := callbacks.Create().After("gorm:begin_transaction").Register("blog:set_editor", setEditor)
+	if err != nil {
+		return err
+	}
+	err = callbacks.Update().After("gorm:begin_transaction").Register("blog:set_editor", setEditor)
+	if err != nil {
+		return err
+	}
+	return callbacks.Delete().After("gorm:begin_transaction").Register("blog:set_editor", setEditor)
+}
+
+// setEditor sets the editor for the rest of the transaction of the statement.
+// gorm runs writes in a transaction, so the setting never leaks to other writes.
+func setEditor(db *gorm.DB) {
+	if db.Error != nil || db.DryRun || db.Statement.Schema == nil || db.Statement.Schema.Table != "blogs" {
+		return
+	}
+	ctx := db.Statement.Context
+	_, err := db.Statement.ConnPool.ExecContext(ctx, "SELECT set_config('blog.editor', $1, true)", editorFrom(ctx))
+	if err != nil {
+		_ = db.AddError(fmt.Errorf("unable to set editor: %w", err))
+	}
+}
+
+// GetBlogRevisions returns a page of the revisions of the blog, the most recent first
+func (s *Service) GetBlogRevisions(ctx context.Context, blogID uint, pagination *Pagination) (*Pagination, error) {
+	revisions := func() *gorm.DB {
+		return s.db.WithContext(ctx).Model(&BlogRevision{}).Where("blog_id = ?", blogID)
+	}
+
+	var totalItems int64
+	if err := revisions().Count(&totalItems).Error; err != nil {
+		s.logger.Error("unable to count blog revisions", "blog_id", blogID, "error", err)
+		return nil, err
+	}
+	if totalItems == 0 {
+		return nil, status.Error(codes.NotFound, "blog not found")
+	}
+	pagination.TotalItems = totalItems
+	pagination.TotalPages = int(math.Ceil(float64(totalItems) / float64(pagination.GetLimit())))
+
+	var items []BlogRevision
+	result := revisions().Order("number desc").Offset(pagination.GetOffset()).Limit(pagination.GetLimit()).Find(&items)
+	if result.Error != nil {
+		s.logger.Error("unable to get blog revisions", "blog_id", blogID, "error", result.Error)
+		return nil, result.Error
+	}
+	s.logger.Info(fmt.Sprintf("found %d blog revisions", result.RowsAffected))
+	pagination.Items = items
+	return pagination, nil
+}
+
+// GetBlogRevision returns the given revision of the blog
+func (s *Service) GetBlogRevision(ctx context.Context, blogID uint, number uint) (*BlogRevision, error) {
+	revision, err := gorm.G[BlogRevision](s.db).Where("blog_id = ? AND number = ?", blogID, number).First(ctx)
+	if err != nil {
+		s.logger.Error("unable to get blog revision", "blog_id", blogID, "revision", number, "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "blog revision not found")
+		}
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// RestoreBlogRevision updates the blog back to the title and body of the given
+// revision, which records a new revision. A non empty etag must match the
+// current version of the blog.
+func (s *Service) RestoreBlogRevision(ctx context.Context, blogID uint, number uint, etag string) error {
+	revision, err := s.GetBlogRevision(ctx, blogID, number)
+	if err != nil {
+		return err
+	}
+	blog := Blog{ID: blogID, Title: revision.Title, Body: revision.Body}
+	return s.UpdateBlog(ctx, blog, []string{"title", "body"}, etag)
+}
+
+// DiffBlogRevisions returns the line diffs of the title and body between two
+// revisions of the blog
+func (s *Service) DiffBlogRevisions(ctx context.Context, blogID uint, from uint, to uint) (title []DiffLine, body []DiffLine, err error) {
+	fromRevision, err := s.GetBlogRevision(ctx, blogID, from)
+	if err != nil {
+		return nil, nil, err
+	}
+	toRevision, err := s.GetBlogRevision(ctx, blogID, to)
+	if err != nil {
+		return nil, nil, err
+	}
+	return diffLines(fromRevision.Title, toRevision.Title), diffLines(fromRevision.Body, toRevision.Body), nil
+}
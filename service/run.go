@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Run runs the background workers of the service until the context is done
+func (s *Service) Run(ctx context.Context) {
+	workers := []func(ctx context.Context){
+		s.listenForChanges,
+		func(ctx context.Context) {
+			s.runPeriodically(ctx, time.Hour, "purge blog changes", s.purgeChanges)
+		},
+		func(ctx context.Context) {
+			s.runPeriodically(ctx, time.Hour, "purge deleted blogs", s.purgeDeletedBlogs)
+		},
+		func(ctx context.Context) {
+			s.runPeriodically(ctx, s.publishInterval, "publish scheduled blogs", s.publishScheduledBlogs)
+		},
+		func(ctx context.Context) {
+			s.runPeriodically(ctx, s.viewFlushInterval, "flush blog views", s.flushViews)
+			// flush the views counted since the last flush before stopping
+			if err := s.flushViews(context.WithoutCancel(ctx)); err != nil {
+				s.logger.Error("background job failed", "job", "flush blog views", "error", err)
+			}
+		},
+	}
+
+	var wg sync.WaitGroup
+	for _, worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// runPeriodically runs the job at every interval until the context is done
+func (s *Service) runPeriodically(ctx context.Context, interval time.Duration, name string, job func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				s.logger.Error("background job failed", "job", name, "error", err)
+			}
+		}
+	}
+}
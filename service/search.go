@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// searchSort is the order of search results, recorded in their page tokens
+const searchSort = "rank desc, id asc"
+
+// SearchResult is a blog matching a search, with its rank and highlighted snippets
+type SearchResult struct {
+	Blog
+	Rank           float64 `json:"rank"`
+	TitleHighlight string  `json:"title_highlight"`
+	BodyHighlight  string  `json:"body_highlight"`
+}
+
+// WithSearchLanguage sets the text search configuration of searches, it must be
+// the one the blogs are indexed with by AutoMigrate
+func WithSearchLanguage(language string) Option {
+	return func(s *Service) {
+		s.searchLanguage = language
+	}
+}
+
+// SearchBlogs returns a page of the blogs whose title or body match the query,
+// best matches first. The query supports the web search syntax of Postgres,
+// e.g. "go -rust" or "\"error handling\"", with the configured search language
+// so that the query matches the indexed search vector.
+func (s *Service) SearchBlogs(ctx context.Context, query string, pagination *Pagination) (*Pagination, error) {
+	language := s.searchLanguage
+	// the cursor filter binds page tokens to the search they were returned for
+	search, err := json.Marshal([]string{language, query})
+	if err != nil {
+		return nil, err
+	}
+	var after []any
+	if pagination.PageToken != "" {
+		c, err := decodePageToken(pagination.PageToken, s.pageTokenSecret)
+		if err != nil {
+			return nil, err
+		}
+		if c.Sort != searchSort || c.Filter != string(search) {
+			return nil, status.Error(codes.InvalidArgument, "page token does not belong to this search")
+		}
+		after, err = decodeSearchCursorValues(c.Values)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	matches := func() *gorm.DB {
+		return s.db.WithContext(ctx).Table("blogs").
+			Where("deleted_at IS NULL AND status = ?", BlogPublished).
+			Where("search_vector @@ websearch_to_tsquery(?::regconfig, ?)", language, query)
+	}
+
+	limit := pagination.GetLimit()
+	results := s.db.WithContext(ctx).Table("(?) AS results", matches().Select(
+		"blogs.*, ts_rank(search_vector, websearch_to_tsquery(?::regconfig, ?))::float8 AS rank", language, query,
+	)).Order(searchSort)
+	if after != nil {
+		results = results.Where("rank < ? OR (rank = ? AND id > ?)", after[0], after[0], after[1]).Limit(limit + 1)
+	} else {
+		var totalItems int64
+		if err := matches().Count(&totalItems).Error; err != nil {
+			s.logger.Error("unable to count search results", "error", err)
+			return nil, err
+		}
+		pagination.TotalItems = totalItems
+		pagination.TotalPages = int(math.Ceil(float64(totalItems) / float64(limit)))
+		results = results.Offset(pagination.GetOffset()).Limit(limit)
+	}
+
+	var items []SearchResult
+	err = results.Select(
+		"results.*, ts_headline(?::regconfig, title, websearch_to_tsquery(?::regconfig, ?)) AS title_highlight, "+
+			"ts_headline(?::regconfig, body, websearch_to_tsquery(?::regconfig, ?), 'MaxFragments=2') AS body_highlight",
+		language, language, query, language, language, query,
+	).Scan(&items).Error
+	if err != nil {
+		s.logger.Error("unable to search blogs", "error", err)
+		return nil, err
+	}
+	s.logger.Info(fmt.Sprintf("found %d blogs", len(items)))
+
+	pagination.Sort = searchSort
+	pagination.NextPageToken = ""
+	hasMore := pagination.PageToken == "" && pagination.Page < pagination.TotalPages
+	if pagination.PageToken != "" && len(items) > limit {
+		items = items[:limit]
+		hasMore = true
+	}
+	if hasMore && len(items) > 0 {
+		last := items[len(items)-1]
+		pagination.NextPageToken, err = encodePageToken(cursor{
+			Sort:   searchSort,
+			Filter: string(search),
+			Values: []any{last.Rank, last.ID},
+		}, s.pageTokenSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pagination.Items = items
+	return pagination, nil
+}
+
+// decodeSearchCursorValues converts the rank and id stored in a search page token
+func decodeSearchCursorValues(values []any) ([]any, error) {
+	invalid := status.Error(codes.InvalidArgument, "invalid page token")
+	if len(values) != 2 {
+		return nil, invalid
+	}
+	rank, ok := values[0].(json.Number)
+	if !ok {
+		return nil, invalid
+	}
+	id, ok := values[1].(json.Number)
+	if !ok {
+		return nil, invalid
+	}
+	decodedRank, err := strconv.ParseFloat(rank.String(), 64)
+	if err != nil {
+		return nil, invalid
+	}
+	decodedID, err := strconv.ParseUint(id.String(), 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	return []any{decodedRank, decodedID}, nil
+}
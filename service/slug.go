@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	maxSlugLength = 80
+	// defaultSlug is used for titles without any letter or digit that can be transliterated
+	defaultSlug = "blog"
+)
+
+// BlogSlug is a former slug of a blog, kept so that links using it still
+// resolve after the title changed
+type BlogSlug struct {
+	Slug      string    `gorm:"primaryKey;size:80" json:"slug"`
+	BlogID    uint      `gorm:"not null;index" json:"blog_id"`
+	Blog      Blog      `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+}
+
+// TableName specifies the table name for the BlogSlug model
+func (BlogSlug) TableName() string {
+	return "blog_slugs"
+}
+
+// transliterations replaces the letters that do not decompose into a latin
+// letter and combining marks
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'ł': "l", 'þ': "th", 'ı': "i",
+	// greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i",
+	'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s",
+	'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	// cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z",
+	'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// slugify returns the URL safe slug of the title: lower case ASCII letters and
+// digits separated by single dashes, with accents removed and other scripts
+// transliterated where possible
+func slugify(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range norm.NFD.String(strings.ToLower(title)) {
+		var text string
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// combining marks left by the decomposition of accented letters
+			continue
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			text = string(r)
+		default:
+			text = transliterations[r]
+		}
+		if text == "" {
+			dash = b.Len() > 0
+			continue
+		}
+		if dash {
+			b.WriteByte('-')
+			dash = false
+		}
+		b.WriteString(text)
+	}
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	if slug == "" {
+		return defaultSlug
+	}
+	return slug
+}
+
+// slugBase returns the slug of the title, leaving room for a collision suffix
+func slugBase(title string) string {
+	base := slugify(title)
+	if len(base) > maxSlugLength-8 {
+		base = strings.TrimRight(base[:maxSlugLength-8], "-")
+	}
+	return base
+}
+
+// slugLockKey returns the key locking the choice of the slugs of base: the
+// base without its numeric suffixes, as the slugs of e.g. "go" and "go 2" may
+// both be go-2
+func slugLockKey(base string) string {
+	for {
+		i := strings.LastIndexByte(base, '-')
+		if i <= 0 || strings.Trim(base[i+1:], "0123456789") != "" {
+			return base
+		}
+		base = base[:i]
+	}
+}
+
+// uniqueSlug returns the slug of the title, suffixed with the lowest number
+// that makes it unique among the current and former slugs of other blogs, and
+// the reserved slugs of blogs about to be created. In a transaction, the slugs
+// of the title are locked until it ends, so that concurrent blogs with the same
+// title wait for the slug of each other instead of taking the same one.
+func uniqueSlug(db *gorm.DB, title string, blogID uint, reserved map[string]bool) (string, error) {
+	base := slugBase(title)
+	pattern := escapeLike(base) + "-%"
+
+	err := db.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", slugLockKey(base)).Error
+	if err != nil {
+		return "", err
+	}
+	var taken []string
+	err = db.Raw(`SELECT slug FROM blogs WHERE (slug = ? OR slug LIKE ?) AND id <> ?
+		UNION SELECT slug FROM blog_slugs WHERE (slug = ? OR slug LIKE ?) AND blog_id <> ?`,
+		base, pattern, blogID, base, pattern, blogID).Scan(&taken).Error
+	if err != nil {
+		return "", err
+	}
+	used := make(map[string]bool, len(taken)+len(reserved))
+	for slug := range reserved {
+		used[slug] = true
+	}
+	for _, slug := range taken {
+		used[slug] = true
+	}
+	if !used[base] {
+		return base, nil
+	}
+	for n := 2; ; n++ {
+		slug := base + "-" + strconv.Itoa(n)
+		if !used[slug] {
+			return slug, nil
+		}
+	}
+}
+
+// updateSlug gives the blog the slug of its new title, and keeps its current
+// slug as a former slug. It returns the current slug when the title does not
+// change it.
+func updateSlug(tx *gorm.DB, id uint, title string) (string, error) {
+	var current string
+	err := tx.Model(&Blog{}).Select("coalesce(slug, '')").Where("id = ?", id).Scan(&current).Error
+	if err != nil {
+		return "", err
+	}
+	base := slugBase(title)
+	if current == base || strings.HasPrefix(current, base+"-") && isSlugSuffix(strings.TrimPrefix(current, base+"-")) {
+		return current, nil
+	}
+	slug, err := uniqueSlug(tx, title, id, nil)
+	if err != nil {
+		return "", err
+	}
+	if current != "" {
+		err = tx.Create(&BlogSlug{Slug: current, BlogID: id}).Error
+		if err != nil && !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return "", err
+		}
+	}
+	// the new slug may be a former slug of the blog
+	err = tx.Where("slug = ? AND blog_id = ?", slug, id).Delete(&BlogSlug{}).Error
+	return slug, err
+}
+
+// isSlugSuffix reports whether text is a collision suffix added by uniqueSlug
+func isSlugSuffix(text string) bool {
+	n, err := strconv.Atoi(text)
+	return err == nil && n >= 2 && strconv.Itoa(n) == text
+}
+
+// backfillSlugs gives a slug to the blogs created before blogs had slugs
+func backfillSlugs(db *gorm.DB) error {
+	var blogs []Blog
+	err := db.Unscoped().Where("slug IS NULL OR slug = ''").Order("id").Find(&blogs).Error
+	if err != nil {
+		return err
+	}
+	for _, blog := range blogs {
+		slug, err := uniqueSlug(db, blog.Title, blog.ID, nil)
+		if err != nil {
+			return err
+		}
+		err = db.Exec("UPDATE blogs SET slug = ? WHERE id = ?", slug, blog.ID).Error
+		if err != nil {
+			return fmt.Errorf("unable to set slug of blog %d: %w", blog.ID, err)
+		}
+	}
+	return nil
+}
+
+// GetBlogBySlug returns the blog with the given current or former slug, and
+// whether the slug is a former one so that callers can redirect to the
+// current slug of the blog
+func (s *Service) GetBlogBySlug(ctx context.Context, slug string) (*Blog, bool, error) {
+	blog, err := blogsWithRelations(s.db).Where("slug = ?", slug).First(ctx)
+	if err == nil {
+		return &blog, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("unable to get blog", "slug", slug, "error", err)
+		return nil, false, err
+	}
+	blog, err = blogsWithRelations(s.db).Where("id = (SELECT blog_id FROM blog_slugs WHERE slug = ?)", slug).First(ctx)
+	if err != nil {
+		s.logger.Error("unable to get blog", "slug", slug, "error", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, status.Error(codes.NotFound, "blog not found")
+		}
+		return nil, false, err
+	}
+	return &blog, true, nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{name: "should lower case and join words with dashes", title: "Hello World", want: "hello-world"},
+		{name: "should collapse and trim separators", title: "  Go -- is _fun_!  ", want: "go-is-fun"},
+		{name: "should remove accents", title: "Café déjà vu", want: "cafe-deja-vu"},
+		{name: "should transliterate special letters", title: "Straße Ærø", want: "strasse-aero"},
+		{name: "should transliterate cyrillic", title: "Привет мир", want: "privet-mir"},
+		{name: "should transliterate greek", title: "Καλημέρα", want: "kalimera"},
+		{name: "should keep digits", title: "Go 1.25 released", want: "go-1-25-released"},
+		{name: "should fall back when nothing can be transliterated", title: "你好", want: "blog"},
+		{name: "should truncate long titles", title: strings.Repeat("ab ", 50), want: strings.TrimSuffix(strings.Repeat("ab-", 27), "-")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, slugify(tt.title))
+		})
+	}
+}
+
+func TestIsSlugSuffix(t *testing.T) {
+	assert.True(t, isSlugSuffix("2"))
+	assert.True(t, isSlugSuffix("15"))
+	assert.False(t, isSlugSuffix("1"))
+	assert.False(t, isSlugSuffix("02"))
+	assert.False(t, isSlugSuffix("go"))
+}
+
+func TestSlugLockKey(t *testing.T) {
+	assert.Equal(t, "go", slugLockKey("go"))
+	assert.Equal(t, "go", slugLockKey("go-2"))
+	assert.Equal(t, "go", slugLockKey("go-2-3"))
+	assert.Equal(t, "go-1-25-released", slugLockKey("go-1-25-released"))
+	assert.Equal(t, "2026", slugLockKey("2026"))
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const maxTagLength = 50
+
+// Tag categorizes blogs, a blog can have many tags and a tag many blogs
+type Tag struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Name      string    `gorm:"not null;size:50;uniqueIndex" json:"name"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName specifies the table name for the Tag model
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// TagCount is a tag and the number of published blogs with it
+type TagCount struct {
+	Name      string `json:"name"`
+	BlogCount int64  `json:"blog_count"`
+}
+
+// NewTags returns the tags with the given names
+func NewTags(names []string) []Tag {
+	tags := make([]Tag, len(names))
+	for i, name := range names {
+		tags[i] = Tag{Name: name}
+	}
+	return tags
+}
+
+// TagNames returns the names of the tags
+func TagNames(tags []Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+// normalizeTag lower cases the name and replaces its spaces with single dashes.
+// Names can only contain letters, digits and the characters in "-_.+#".
+func normalizeTag(name string) (string, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(name)), "-")
+	if normalized == "" || len(normalized) > maxTagLength {
+		return "", status.Errorf(codes.InvalidArgument, "invalid tag %q: must have between 1 and %d characters", name, maxTagLength)
+	}
+	for _, r := range normalized {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_.+#", r) {
+			return "", status.Errorf(codes.InvalidArgument, "invalid tag %q: unexpected character %q", name, r)
+		}
+	}
+	return normalized, nil
+}
+
+// normalizeTags normalizes the names, sorts them and removes duplicates
+func normalizeTags(names []string) ([]string, error) {
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		tag, err := normalizeTag(name)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, tag)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// normalizeOptionalTag normalizes the name unless it is empty
+func normalizeOptionalTag(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	return normalizeTag(name)
+}
+
+// findOrCreateTags returns the tags with the names of the given tags, creating
+// the ones that do not exist
+func findOrCreateTags(tx *gorm.DB, tags []Tag) ([]Tag, error) {
+	names, err := normalizeTags(TagNames(tags))
+	if err != nil || len(names) == 0 {
+		return nil, err
+	}
+	err = tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).Create(NewTags(names)).Error
+	if err != nil {
+		return nil, err
+	}
+	var found []Tag
+	err = tx.Where("name IN ?", names).Order("name").Find(&found).Error
+	return found, err
+}
+
+// replaceTags replaces all the tags of the blog
+func replaceTags(tx *gorm.DB, id uint, tags []Tag) error {
+	tags, err := findOrCreateTags(tx, tags)
+	if err != nil {
+		return err
+	}
+	association := tx.Model(&Blog{ID: id}).Association("Tags")
+	if len(tags) == 0 {
+		return association.Clear()
+	}
+	return association.Replace(tags)
+}
+
+// preloadBlogRelations loads the tags of the blogs sorted by name, their
+// authors and their reaction counts, with one query per relation
+func preloadBlogRelations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tags.name")
+	}).Preload("Author").Preload("ReactionCounts", func(db *gorm.DB) *gorm.DB {
+		return db.Where("count > 0").Order("type")
+	})
+}
+
+// blogsWithRelations starts a query of blogs with the generics API, loading
+// the same relations as preloadBlogRelations
+func blogsWithRelations(db *gorm.DB) gorm.ChainInterface[Blog] {
+	return gorm.G[Blog](db).Preload("Tags", func(db gorm.PreloadBuilder) error {
+		db.Order("tags.name")
+		return nil
+	}).Preload("Author", nil).Preload("ReactionCounts", func(db gorm.PreloadBuilder) error {
+		db.Where("count > 0").Order("type")
+		return nil
+	})
+}
+
+// tagNode matches the blogs with the tag
+type tagNode struct {
+	name string
+}
+
+func (n tagNode) sql() (string, []any) {
+	return "EXISTS (SELECT 1 FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id WHERE blog_tags.blog_id = blogs.id AND tags.name = ?)", []any{n.name}
+}
+
+func (n tagNode) matches(blog Blog) bool {
+	return slices.Contains(TagNames(blog.Tags), n.name)
+}
+
+// tagFilter restricts the filter to the blogs with the tag, it returns the
+// filter unchanged when tag is empty
+func tagFilter(filter filterNode, tag string) filterNode {
+	if tag == "" {
+		return filter
+	}
+	if filter == nil {
+		return tagNode{name: tag}
+	}
+	return andNode{children: []filterNode{tagNode{name: tag}, filter}}
+}
+
+// GetTags returns a page of the tags with the number of published blogs with
+// each of them, the most used first. Tags that are not used by any published
+// blog are returned with a zero count.
+func (s *Service) GetTags(ctx context.Context, pagination *Pagination) (*Pagination, error) {
+	var totalItems int64
+	if err := s.db.WithContext(ctx).Model(&Tag{}).Count(&totalItems).Error; err != nil {
+		s.logger.Error("unable to count tags", "error", err)
+		return nil, err
+	}
+	pagination.TotalItems = totalItems
+	pagination.TotalPages = int(math.Ceil(float64(totalItems) / float64(pagination.GetLimit())))
+
+	var items []TagCount
+	err := s.db.WithContext(ctx).Model(&Tag{}).
+		Select("tags.name, count(blogs.id) AS blog_count").
+		Joins("LEFT JOIN blog_tags ON blog_tags.tag_id = tags.id").
+		Joins("LEFT JOIN blogs ON blogs.id = blog_tags.blog_id AND blogs.deleted_at IS NULL AND blogs.status = ?", BlogPublished).
+		Group("tags.id, tags.name").
+		Order("blog_count desc, tags.name").
+		Offset(pagination.GetOffset()).Limit(pagination.GetLimit()).
+		Scan(&items).Error
+	if err != nil {
+		s.logger.Error("unable to get tags", "error", err)
+		return nil, err
+	}
+	s.logger.Info(fmt.Sprintf("found %d tags", len(items)))
+	pagination.Items = items
+	return pagination, nil
+}
+
+// RenameTag renames the tag, its blogs keep it. Renaming fails with
+// AlreadyExists when another tag has the new name.
+func (s *Service) RenameTag(ctx context.Context, name string, newName string) error {
+	name, err := normalizeTag(name)
+	if err != nil {
+		return err
+	}
+	newName, err = normalizeTag(newName)
+	if err != nil {
+		return err
+	}
+	rows, err := gorm.G[Tag](s.db).Where("name = ?", name).Update(ctx, "name", newName)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return status.Errorf(codes.AlreadyExists, "tag %q already exists, merge the tags instead", newName)
+	}
+	if err != nil {
+		s.logger.Error("unable to rename tag", "name", name, "error", err)
+		return err
+	}
+	if rows == 0 {
+		return status.Errorf(codes.NotFound, "tag %q not found", name)
+	}
+	s.logger.Info("renamed tag", "name", name, "new_name", newName)
+	return nil
+}
+
+// MergeTags tags the blogs of the source tags with the target tag, creating it
+// when it does not exist, and deletes the source tags
+func (s *Service) MergeTags(ctx context.Context, sources []string, target string) error {
+	sources, err := normalizeTags(sources)
+	if err != nil {
+		return err
+	}
+	target, err = normalizeTag(target)
+	if err != nil {
+		return err
+	}
+	sources = slices.DeleteFunc(sources, func(source string) bool {
+		return source == target
+	})
+	if len(sources) == 0 {
+		return nil
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var found []Tag
+		if err := tx.Where("name IN ?", sources).Find(&found).Error; err != nil {
+			return err
+		}
+		for _, source := range sources {
+			if !slices.Contains(TagNames(found), source) {
+				return status.Errorf(codes.NotFound, "tag %q not found", source)
+			}
+		}
+		targets, err := findOrCreateTags(tx, []Tag{{Name: target}})
+		if err != nil {
+			return err
+		}
+		ids := make([]uint, len(found))
+		for i, tag := range found {
+			ids[i] = tag.ID
+		}
+		err = tx.Exec(`INSERT INTO blog_tags (blog_id, tag_id)
+			SELECT DISTINCT blog_id, ? FROM blog_tags WHERE tag_id IN ?
+			ON CONFLICT DO NOTHING`, targets[0].ID, ids).Error
+		if err != nil {
+			return err
+		}
+		// the associations of the sources are deleted with them
+		return tx.Delete(&Tag{}, ids).Error
+	})
+	if err != nil {
+		s.logger.Error("unable to merge tags", "sources", sources, "target", target, "error", err)
+		return err
+	}
+	s.logger.Info("merged tags", "sources", sources, "target", target)
+	return nil
+}
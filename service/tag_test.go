@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		names     []string
+		want      []string
+		wantError codes.Code
+	}{
+		{
+			name:  "should lower case the names and replace their spaces with dashes",
+			names: []string{"Go", "  Web   Development "},
+			want:  []string{"go", "web-development"},
+		},
+		{
+			name:  "should sort the names and remove duplicates",
+			names: []string{"rust", "GO", "go", "c++"},
+			want:  []string{"c++", "go", "rust"},
+		},
+		{
+			name:  "should keep letters of other scripts",
+			names: []string{"Café", "ελληνικά"},
+			want:  []string{"café", "ελληνικά"},
+		},
+		{
+			name:      "should fail when a name is blank",
+			names:     []string{"go", "   "},
+			wantError: codes.InvalidArgument,
+		},
+		{
+			name:      "should fail when a name has unexpected characters",
+			names:     []string{"go/rust"},
+			wantError: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeTags(tt.names)
+			if tt.wantError != codes.OK {
+				assert.Equal(t, tt.wantError, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagFilter(t *testing.T) {
+	filter, err := parseFilter(`title:"go"`)
+	assert.NoError(t, err)
+
+	assert.Nil(t, tagFilter(nil, ""))
+	assert.Equal(t, filter, tagFilter(filter, ""))
+
+	sql, args := tagFilter(filter, "go").sql()
+	assert.Equal(t, "(EXISTS (SELECT 1 FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id WHERE blog_tags.blog_id = blogs.id AND tags.name = ?)) AND (title ILIKE ?)", sql)
+	assert.Equal(t, []any{"go", "%go%"}, args)
+
+	assert.True(t, tagNode{name: "go"}.matches(Blog{Tags: NewTags([]string{"go", "rust"})}))
+	assert.False(t, tagNode{name: "go"}.matches(Blog{}))
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultViewFlushInterval is how often the buffered views are written to the database
+const DefaultViewFlushInterval = 10 * time.Second
+
+// maxViewStatsDays is the longest date range returned by GetBlogViewStats
+const maxViewStatsDays = 366
+
+// BlogView is the number of views of a blog on a day in UTC
+type BlogView struct {
+	BlogID uint      `gorm:"primaryKey;autoIncrement:false" json:"blog_id"`
+	Blog   Blog      `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+	Day    time.Time `gorm:"primaryKey;type:date" json:"day"`
+	Views  int64     `gorm:"not null;default:0" json:"views"`
+}
+
+// TableName specifies the table name for the BlogView model
+func (BlogView) TableName() string {
+	return "blog_views"
+}
+
+// WithViewFlushInterval sets how often the buffered views are written to the database
+func WithViewFlushInterval(interval time.Duration) Option {
+	return func(s *Service) {
+		s.viewFlushInterval = interval
+	}
+}
+
+// blogDay identifies the views of a blog on a day
+type blogDay struct {
+	blogID uint
+	day    time.Time
+}
+
+// viewBuffer counts the views in memory between flushes, so reading a blog
+// does not write to the database
+type viewBuffer struct {
+	mu    sync.Mutex
+	views map[blogDay]int64
+}
+
+func newViewBuffer() *viewBuffer {
+	return &viewBuffer{views: map[blogDay]int64{}}
+}
+
+// add counts views of the blog on the day
+func (b *viewBuffer) add(key blogDay, views int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.views[key] += views
+}
+
+// take returns the counted views and empties the buffer
+func (b *viewBuffer) take() map[blogDay]int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	views := b.views
+	b.views = map[blogDay]int64{}
+	return views
+}
+
+// pending returns the counted views of the blog by day
+func (b *viewBuffer) pending(blogID uint) map[time.Time]int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	views := map[time.Time]int64{}
+	for key, count := range b.views {
+		if key.blogID == blogID {
+			views[key.day] += count
+		}
+	}
+	return views
+}
+
+// utcDay returns the start of the day of t in UTC
+func utcDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// RecordView counts a view of the blog today. The view is buffered and
+// written to the database with the next flush.
+func (s *Service) RecordView(ctx context.Context, id uint) error {
+	blog, err := s.findBlog(ctx, id)
+	if err != nil {
+		return err
+	}
+	s.CountView(blog)
+	return nil
+}
+
+// CountView counts a view today of a blog that was just read, without looking
+// it up again. The view is buffered and written to the database with the next
+// flush.
+func (s *Service) CountView(blog *Blog) {
+	s.views.add(blogDay{blogID: blog.ID, day: utcDay(time.Now())}, 1)
+}
+
+// flushViews adds the buffered views to the daily views of the blogs. Views of
+// blogs purged since they were counted are dropped, and the views are kept in
+// the buffer for the next flush when they cannot be written.
+func (s *Service) flushViews(ctx context.Context) error {
+	views := s.views.take()
+	if len(views) == 0 {
+		return nil
+	}
+	var ids []uint
+	for key := range views {
+		ids = append(ids, key.blogID)
+	}
+	var existing []uint
+	err := s.db.WithContext(ctx).Unscoped().Model(&Blog{}).Where("id IN ?", ids).Pluck("id", &existing).Error
+	if err != nil {
+		s.restoreViews(views)
+		return err
+	}
+	exists := map[uint]bool{}
+	for _, id := range existing {
+		exists[id] = true
+	}
+	var rows []BlogView
+	for key, count := range views {
+		if exists[key.blogID] {
+			rows = append(rows, BlogView{BlogID: key.blogID, Day: key.day, Views: count})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	err = s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "blog_id"}, {Name: "day"}},
+		DoUpdates: clause.Set{{Column: clause.Column{Name: "views"}, Value: gorm.Expr("blog_views.views + excluded.views")}},
+	}).Create(&rows).Error
+	if err != nil {
+		s.restoreViews(views)
+		return err
+	}
+	s.logger.Info(fmt.Sprintf("flushed views of %d blog days", len(rows)))
+	return nil
+}
+
+// restoreViews puts views that could not be flushed back into the buffer
+func (s *Service) restoreViews(views map[blogDay]int64) {
+	for key, count := range views {
+		s.views.add(key, count)
+	}
+}
+
+// GetBlogViewStats returns the views of the blog on every day from from to to,
+// including the days without views and the views not flushed yet. The range
+// defaults to the last 30 days.
+func (s *Service) GetBlogViewStats(ctx context.Context, blogID uint, from time.Time, to time.Time) ([]BlogView, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	to = utcDay(to)
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -29)
+	}
+	from = utcDay(from)
+	if from.After(to) {
+		return nil, status.Error(codes.InvalidArgument, "from must not be after to")
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > maxViewStatsDays {
+		return nil, status.Errorf(codes.InvalidArgument, "date range must be at most %d days", maxViewStatsDays)
+	}
+	if _, err := s.findBlog(ctx, blogID); err != nil {
+		return nil, err
+	}
+
+	var flushed []BlogView
+	err := s.db.WithContext(ctx).Where("blog_id = ? AND day BETWEEN ? AND ?", blogID, from, to).Find(&flushed).Error
+	if err != nil {
+		s.logger.Error("unable to get blog views", "blog_id", blogID, "error", err)
+		return nil, err
+	}
+	views := s.views.pending(blogID)
+	for _, view := range flushed {
+		views[utcDay(view.Day)] += view.Views
+	}
+	var stats []BlogView
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		stats = append(stats, BlogView{BlogID: blogID, Day: day, Views: views[day]})
+	}
+	return stats, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewBuffer(t *testing.T) {
+	today := utcDay(time.Now())
+	yesterday := today.AddDate(0, 0, -1)
+
+	buffer := newViewBuffer()
+	buffer.add(blogDay{blogID: 1, day: today}, 1)
+	buffer.add(blogDay{blogID: 1, day: today}, 2)
+	buffer.add(blogDay{blogID: 1, day: yesterday}, 1)
+	buffer.add(blogDay{blogID: 2, day: today}, 5)
+
+	assert.Equal(t, map[time.Time]int64{today: 3, yesterday: 1}, buffer.pending(1))
+
+	views := buffer.take()
+	assert.Equal(t, int64(3), views[blogDay{blogID: 1, day: today}])
+	assert.Equal(t, int64(5), views[blogDay{blogID: 2, day: today}])
+	assert.Empty(t, buffer.pending(1))
+	assert.Empty(t, buffer.take())
+}
+
+func TestUTCDay(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+	got := utcDay(time.Date(2024, 3, 1, 0, 30, 0, 0, berlin))
+	assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), got)
+}
+
+func TestCountView(t *testing.T) {
+	// without a database, the view can only be counted if the blog is not looked up again
+	s := &Service{views: newViewBuffer()}
+	s.CountView(&Blog{ID: 1})
+	s.CountView(&Blog{ID: 1})
+
+	assert.Equal(t, map[time.Time]int64{utcDay(time.Now()): 2}, s.views.pending(1))
+}
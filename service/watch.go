@@ -0,0 +1,490 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultWatchBufferSize is how many changes a watcher can fall behind before it is disconnected
+	DefaultWatchBufferSize = 100
+	// DefaultChangeRetention is how long changes are kept to resume watching from
+	DefaultChangeRetention = 24 * time.Hour
+
+	changesChannel      = "blog_changes"
+	changesBatchSize    = 500
+	changesPollInterval = time.Second
+	listenRetryBackoff  = time.Second
+)
+
+// ChangeType is the kind of change made to a blog
+type ChangeType string
+
+const (
+	ChangeCreated ChangeType = "created"
+	ChangeUpdated ChangeType = "updated"
+	ChangeDeleted ChangeType = "deleted"
+)
+
+// BlogChange is a change made to a blog, recorded by a trigger on the blogs
+// table. Changes are numbered when their transaction commits, so they are
+// published in the order of their sequence number.
+type BlogChange struct {
+	Sequence  uint64     `gorm:"primaryKey;autoIncrement" json:"sequence"`
+	BlogID    uint       `gorm:"not null;index" json:"blog_id"`
+	Type      ChangeType `gorm:"not null;size:16" json:"type"`
+	Data      []byte     `gorm:"type:jsonb;not null" json:"data"`
+	CreatedAt time.Time  `gorm:"not null;index" json:"created_at"`
+}
+
+// TableName specifies the table name for the BlogChange model
+func (BlogChange) TableName() string {
+	return "blog_changes"
+}
+
+// Blog returns the blog as it was after the change, or before it for deletions
+func (c BlogChange) Blog() (Blog, error) {
+	var blog Blog
+	err := json.Unmarshal(c.Data, &blog)
+	return blog, err
+}
+
+// changesTriggerSQL records every change of the blogs table in blog_changes.
+// Soft deleting and undeleting a blog are recorded as deleted and created
+// changes, while changes to deleted blogs, including purging them, are not
+// recorded. The sequence of a change is only assigned when its transaction
+// commits, by a deferred trigger that also notifies the listeners of all
+// server instances, so that a change committed later always gets a higher
+// sequence.
+const changesTriggerSQL = `
+CREATE OR REPLACE FUNCTION record_blog_change() RETURNS trigger AS $$
+DECLARE
+	row_data jsonb;
+	change_type text;
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		IF OLD.deleted_at IS NOT NULL THEN
+			RETURN NULL;
+		END IF;
+		row_data := to_jsonb(OLD) - 'search_vector';
+		change_type := 'deleted';
+	ELSIF TG_OP = 'INSERT' THEN
+		row_data := to_jsonb(NEW) - 'search_vector';
+		change_type := 'created';
+	ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+		row_data := to_jsonb(NEW) - 'search_vector';
+		change_type := 'deleted';
+	ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+		row_data := to_jsonb(NEW) - 'search_vector';
+		change_type := 'created';
+	ELSIF NEW.deleted_at IS NOT NULL THEN
+		RETURN NULL;
+	ELSE
+		row_data := to_jsonb(NEW) - 'search_vector';
+		change_type := 'updated';
+	END IF;
+	INSERT INTO blog_changes (blog_id, type, data, created_at)
+		VALUES ((row_data->>'id')::bigint, change_type, row_data, now());
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS record_blog_change ON blogs;
+CREATE TRIGGER record_blog_change AFTER INSERT OR UPDATE OR DELETE ON blogs
+	FOR EACH ROW EXECUTE FUNCTION record_blog_change();
+
+CREATE OR REPLACE FUNCTION sequence_blog_change() RETURNS trigger AS $$
+DECLARE
+	change_sequence bigint;
+BEGIN
+	UPDATE blog_changes SET sequence = nextval(pg_get_serial_sequence('blog_changes', 'sequence'))
+		WHERE sequence = NEW.sequence
+		RETURNING sequence INTO change_sequence;
+	PERFORM pg_notify('blog_changes', change_sequence::text);
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS sequence_blog_change ON blog_changes;
+CREATE CONSTRAINT TRIGGER sequence_blog_change AFTER INSERT ON blog_changes
+	DEFERRABLE INITIALLY DEFERRED
+	FOR EACH ROW EXECUTE FUNCTION sequence_blog_change();
+`
+
+// lastSequenceSQL returns the last sequence assigned to a change, 0 when none was
+const lastSequenceSQL = "SELECT coalesce(pg_sequence_last_value(pg_get_serial_sequence('blog_changes', 'sequence')::regclass), 0)"
+
+// changesWritersSQL returns the virtual transaction ids of the transactions
+// writing to blog_changes, in any session of the database
+const changesWritersSQL = `SELECT DISTINCT virtualtransaction FROM pg_locks
+	WHERE locktype = 'relation' AND relation = 'blog_changes'::regclass AND mode = 'RowExclusiveLock'
+		AND database = (SELECT oid FROM pg_database WHERE datname = current_database())`
+
+// runningWritersSQL counts the transactions of the virtual transaction ids that are still running
+const runningWritersSQL = "SELECT count(*) FROM pg_locks WHERE locktype = 'virtualxid' AND virtualxid IN ?"
+
+// changesWatermark finds the sequence up to which the changes are settled,
+// i.e. every change with a lower sequence is committed or rolled back. Only a
+// transaction writing to blog_changes can still commit a change with a lower
+// sequence than the last one assigned, so that sequence is settled as soon as
+// the writers seen after reading it have ended. Transactions not writing to
+// blog_changes never hold the changes back.
+type changesWatermark struct {
+	settled uint64
+	known   bool
+	// pending is the last sequence read while writers were running
+	pending uint64
+	writers []string
+}
+
+// update returns the settled sequence, and whether it is known yet
+func (w *changesWatermark) update(ctx context.Context, db *gorm.DB) (uint64, bool, error) {
+	db = db.WithContext(ctx)
+	if len(w.writers) > 0 {
+		var running int64
+		err := db.Raw(runningWritersSQL, w.writers).Scan(&running).Error
+		if err != nil {
+			return 0, false, err
+		}
+		if running > 0 {
+			return w.settled, w.known, nil
+		}
+		w.settled, w.known, w.writers = w.pending, true, nil
+	}
+	// the writers are read after the sequence so that every change with a
+	// lower sequence is either visible or written by one of them
+	var sequence uint64
+	err := db.Raw(lastSequenceSQL).Scan(&sequence).Error
+	if err != nil {
+		return 0, false, err
+	}
+	var writers []string
+	err = db.Raw(changesWritersSQL).Scan(&writers).Error
+	if err != nil {
+		return 0, false, err
+	}
+	if len(writers) == 0 {
+		w.settled, w.known = sequence, true
+	} else {
+		w.pending, w.writers = sequence, writers
+	}
+	return w.settled, w.known, nil
+}
+
+// WithWatchBufferSize sets how many changes a watcher can fall behind before it is disconnected
+func WithWatchBufferSize(size int) Option {
+	return func(s *Service) {
+		s.watchBufferSize = size
+	}
+}
+
+// WithChangeRetention sets how long changes are kept to resume watching from
+func WithChangeRetention(retention time.Duration) Option {
+	return func(s *Service) {
+		s.changeRetention = retention
+	}
+}
+
+// errWatchStopped ends the streams of the watchers when the server shuts down
+var errWatchStopped = status.Error(codes.Unavailable, "server is shutting down, resume from the last received sequence")
+
+// watcher receives the changes published by the hub
+type watcher struct {
+	changes chan BlogChange
+	err     error
+}
+
+// changeHub fans out the changes read by the listener to the watchers
+type changeHub struct {
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+	// last is the sequence of the last published change
+	last    uint64
+	started bool
+	closed  bool
+}
+
+func newChangeHub() *changeHub {
+	return &changeHub{watchers: map[*watcher]struct{}{}}
+}
+
+func (h *changeHub) subscribe(bufferSize int) *watcher {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	w := &watcher{changes: make(chan BlogChange, bufferSize)}
+	if h.closed {
+		w.err = errWatchStopped
+		close(w.changes)
+		return w
+	}
+	h.watchers[w] = struct{}{}
+	return w
+}
+
+func (h *changeHub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		close(w.changes)
+	}
+}
+
+func (h *changeHub) lastSequence() (uint64, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.last, h.started
+}
+
+// start sets the sequence after which changes are published
+func (h *changeHub) start(last uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.last = last
+	h.started = true
+}
+
+// close disconnects the watchers and the ones subscribing later
+func (h *changeHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for w := range h.watchers {
+		w.err = errWatchStopped
+		delete(h.watchers, w)
+		close(w.changes)
+	}
+}
+
+// publish sends the change to every watcher without blocking. Watchers whose
+// buffer is full are disconnected so that a slow consumer never holds back
+// the others.
+func (h *changeHub) publish(change BlogChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if change.Sequence <= h.last {
+		return
+	}
+	h.last = change.Sequence
+	for w := range h.watchers {
+		select {
+		case w.changes <- change:
+		default:
+			w.err = status.Error(codes.ResourceExhausted, "watcher is too slow, resume from the last received sequence")
+			delete(h.watchers, w)
+			close(w.changes)
+		}
+	}
+}
+
+// listenForChanges publishes the changes notified by the database until the
+// context is done, reconnecting when the connection is lost
+func (s *Service) listenForChanges(ctx context.Context) {
+	for {
+		err := s.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		s.logger.Error("lost connection listening for blog changes", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryBackoff):
+		}
+	}
+}
+
+// listen holds a database connection to LISTEN for changes and publishes them
+func (s *Service) listen(ctx context.Context) error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	return conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unsupported database driver %T", driverConn)
+		}
+		pgxConn := stdlibConn.Conn()
+		if _, err := pgxConn.Exec(ctx, "LISTEN "+changesChannel); err != nil {
+			return err
+		}
+		s.logger.Info("listening for blog changes")
+
+		// publish the changes made while not listening
+		var watermark changesWatermark
+		if err := s.publishChanges(ctx, &watermark); err != nil {
+			return err
+		}
+		for {
+			// changes held back by a running writer are not notified again
+			// once it ends, so they are also polled for
+			waitCtx, cancel := context.WithTimeout(ctx, changesPollInterval)
+			_, err := pgxConn.WaitForNotification(waitCtx)
+			cancel()
+			if err != nil && (ctx.Err() != nil || !errors.Is(err, context.DeadlineExceeded)) {
+				return err
+			}
+			if err := s.publishChanges(ctx, &watermark); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// publishChanges publishes the settled changes following the last published
+// one. The first time, it starts after the settled changes since there is
+// nobody to catch up.
+func (s *Service) publishChanges(ctx context.Context, watermark *changesWatermark) error {
+	settled, known, err := watermark.update(ctx, s.db)
+	if err != nil || !known {
+		return err
+	}
+	last, started := s.changes.lastSequence()
+	if !started {
+		s.changes.start(settled)
+		return nil
+	}
+	for last < settled {
+		changes, err := s.changesBetween(ctx, last, settled)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			s.changes.publish(change)
+			last = change.Sequence
+		}
+		if len(changes) < changesBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// changesBetween returns the next batch of changes after the sequence after, up
+// to the sequence until
+func (s *Service) changesBetween(ctx context.Context, after, until uint64) ([]BlogChange, error) {
+	return gorm.G[BlogChange](s.db).
+		Where("sequence > ? AND sequence <= ?", after, until).
+		Order("sequence").Limit(changesBatchSize).Find(ctx)
+}
+
+// purgeChanges deletes the changes older than the retention period, except for
+// the last one which marks where the retained changes start
+func (s *Service) purgeChanges(ctx context.Context) error {
+	rows, err := gorm.G[BlogChange](s.db).
+		Where("sequence < (SELECT max(sequence) FROM blog_changes WHERE created_at < ?)", time.Now().Add(-s.changeRetention)).
+		Delete(ctx)
+	if err != nil {
+		return err
+	}
+	s.logger.Info("purged blog changes", "rows", rows)
+	return nil
+}
+
+// StopWatchers ends the WatchBlogs streams with Unavailable, including the
+// ones started afterwards, as they never end on their own and would otherwise
+// hold up a graceful shutdown until its deadline
+func (s *Service) StopWatchers() {
+	s.changes.close()
+}
+
+// WatchBlogs sends the changes made to the blogs that match the filter and
+// types until the context is done or the watcher falls too far behind. When
+// after is set, the changes following that sequence are sent first.
+func (s *Service) WatchBlogs(ctx context.Context, after uint64, filter string, types []ChangeType, send func(BlogChange) error) error {
+	node, err := parseFilter(filter)
+	if err != nil {
+		return err
+	}
+	matches := func(change BlogChange) (bool, error) {
+		if len(types) > 0 && !slices.Contains(types, change.Type) {
+			return false, nil
+		}
+		if node == nil {
+			return true, nil
+		}
+		blog, err := change.Blog()
+		if err != nil {
+			return false, err
+		}
+		return node.matches(blog), nil
+	}
+	deliver := func(change BlogChange) error {
+		ok, err := matches(change)
+		if err != nil || !ok {
+			return err
+		}
+		return send(change)
+	}
+
+	// subscribe before catching up so that no change is missed in between
+	w := s.changes.subscribe(s.watchBufferSize)
+	defer s.changes.unsubscribe(w)
+
+	last, started := s.changes.lastSequence()
+	if after > 0 {
+		if !started {
+			return status.Error(codes.Unavailable, "changes are not available yet, retry later")
+		}
+		var oldest sql.NullInt64
+		err := s.db.WithContext(ctx).Model(&BlogChange{}).Select("min(sequence)").Scan(&oldest).Error
+		if err != nil {
+			return err
+		}
+		if oldest.Valid && after < uint64(oldest.Int64) {
+			return status.Errorf(codes.OutOfRange, "changes after sequence %d are no longer available", after)
+		}
+		for position := after; position < last; {
+			changes, err := s.changesBetween(ctx, position, last)
+			if err != nil {
+				return err
+			}
+			for _, change := range changes {
+				if err := deliver(change); err != nil {
+					return err
+				}
+				position = change.Sequence
+			}
+			if len(changes) < changesBatchSize {
+				break
+			}
+		}
+		last = max(last, after)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case change, ok := <-w.changes:
+			if !ok {
+				return w.err
+			}
+			if change.Sequence <= last {
+				continue
+			}
+			if err := deliver(change); err != nil {
+				return err
+			}
+			last = change.Sequence
+		}
+	}
+}
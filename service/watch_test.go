@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestChangeHubClose(t *testing.T) {
+	hub := newChangeHub()
+	hub.start(0)
+	w := hub.subscribe(1)
+
+	hub.close()
+	_, ok := <-w.changes
+	assert.False(t, ok)
+	assert.Equal(t, codes.Unavailable, status.Code(w.err))
+
+	// watchers subscribing after the hub is closed are disconnected right away
+	w = hub.subscribe(1)
+	_, ok = <-w.changes
+	assert.False(t, ok)
+	assert.Equal(t, codes.Unavailable, status.Code(w.err))
+
+	// unsubscribing a disconnected watcher does not close its channel again
+	hub.unsubscribe(w)
+}